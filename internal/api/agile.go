@@ -16,3 +16,8 @@ func (c *Client) AgileGet(ctx context.Context, path string) ([]byte, error) {
 func (c *Client) AgilePost(ctx context.Context, path string, body []byte) ([]byte, error) {
 	return c.doRequest(ctx, http.MethodPost, basePathAgile+path, body)
 }
+
+// AgilePut performs a PUT request to the Jira Agile API.
+func (c *Client) AgilePut(ctx context.Context, path string, body []byte) ([]byte, error) {
+	return c.doRequest(ctx, http.MethodPut, basePathAgile+path, body)
+}
@@ -31,6 +31,19 @@ func SetVerboseOutput(w io.Writer) {
 	verboseWriter = w
 }
 
+// Recorder is called after each successful request that changes data.
+// body is nil for streamed uploads. Requests marked ReadOnly are skipped.
+type Recorder func(ctx context.Context, method, path string, body []byte)
+
+// recorder receives mutating requests (nil = disabled).
+var recorder Recorder
+
+// SetRecorder registers r to be called after each successful POST, PUT, or
+// DELETE request.
+func SetRecorder(r Recorder) {
+	recorder = r
+}
+
 // Client is a Jira REST API client.
 type Client struct {
 	baseURL        string
@@ -90,10 +103,12 @@ func (e *APIError) Error() string {
 }
 
 // jiraErrorResponse matches Jira's error response format.
+// ErrorMessage is the single-message form used by the Service Management API.
 type jiraErrorResponse struct {
 	ErrorMessages   []string          `json:"errorMessages"`
 	Errors          map[string]string `json:"errors"`
 	WarningMessages []string          `json:"warningMessages"`
+	ErrorMessage    string            `json:"errorMessage"`
 }
 
 // Get performs a GET request to the Jira v3 API.
@@ -168,6 +183,9 @@ func (c *Client) DownloadToWriter(ctx context.Context, path string, dest io.Writ
 		var jiraErr jiraErrorResponse
 		if json.Unmarshal(respBody, &jiraErr) == nil {
 			apiErr.Messages = jiraErr.ErrorMessages
+			if jiraErr.ErrorMessage != "" {
+				apiErr.Messages = append(apiErr.Messages, jiraErr.ErrorMessage)
+			}
 			apiErr.Errors = jiraErr.Errors
 		} else if len(respBody) > 0 {
 			apiErr.RawBody = string(respBody)
@@ -184,6 +202,10 @@ func (c *Client) DownloadToWriter(ctx context.Context, path string, dest io.Writ
 }
 
 func (c *Client) doMultipartRequest(ctx context.Context, method, path, contentType string, body io.Reader, contentLength int64) ([]byte, error) {
+	if err := c.checkPolicy(ctx, method, path, nil); err != nil {
+		return nil, err
+	}
+
 	url := c.baseURL + path
 
 	req, err := http.NewRequestWithContext(ctx, method, url, body)
@@ -235,6 +257,9 @@ func (c *Client) doMultipartRequest(ctx context.Context, method, path, contentTy
 		var jiraErr jiraErrorResponse
 		if json.Unmarshal(respBody, &jiraErr) == nil {
 			apiErr.Messages = jiraErr.ErrorMessages
+			if jiraErr.ErrorMessage != "" {
+				apiErr.Messages = append(apiErr.Messages, jiraErr.ErrorMessage)
+			}
 			apiErr.Errors = jiraErr.Errors
 		} else if len(respBody) > 0 {
 			apiErr.RawBody = string(respBody)
@@ -243,10 +268,17 @@ func (c *Client) doMultipartRequest(ctx context.Context, method, path, contentTy
 		return nil, apiErr
 	}
 
+	if recorder != nil && !isReadRequest(method, path) {
+		recorder(ctx, method, path, nil)
+	}
+
 	return respBody, nil
 }
 
 func (c *Client) doRequest(ctx context.Context, method, path string, body []byte) ([]byte, error) {
+	if err := c.checkPolicy(ctx, method, path, body); err != nil {
+		return nil, err
+	}
 	return c.doRequestWithRetry(ctx, method, path, body, 0)
 }
 
@@ -317,6 +349,9 @@ func (c *Client) doRequestWithRetry(ctx context.Context, method, path string, bo
 		var jiraErr jiraErrorResponse
 		if json.Unmarshal(respBody, &jiraErr) == nil {
 			apiErr.Messages = jiraErr.ErrorMessages
+			if jiraErr.ErrorMessage != "" {
+				apiErr.Messages = append(apiErr.Messages, jiraErr.ErrorMessage)
+			}
 			apiErr.Errors = jiraErr.Errors
 		} else if len(respBody) > 0 {
 			apiErr.RawBody = string(respBody)
@@ -325,6 +360,10 @@ func (c *Client) doRequestWithRetry(ctx context.Context, method, path string, bo
 		return nil, apiErr
 	}
 
+	if recorder != nil && !isReadRequest(method, path) {
+		recorder(ctx, method, path, body)
+	}
+
 	return respBody, nil
 }
 
@@ -93,6 +93,38 @@ func TestClient_Post_Success(t *testing.T) {
 	}
 }
 
+func TestClient_Recorder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/rest/api/3/fail" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	var recorded []string
+	SetRecorder(func(ctx context.Context, method, path string, body []byte) {
+		recorded = append(recorded, method+" "+path+" "+string(body))
+	})
+	defer SetRecorder(nil)
+
+	client := NewClient(testConfig(server.URL))
+	ctx := context.Background()
+	_, _ = client.Get(ctx, "/read")
+	_, _ = client.Put(ctx, "/issue/TEST-1", []byte(`{"fields":{}}`))
+	_, _ = client.Post(ctx, "/fail", []byte(`{}`))
+	_, _ = client.Delete(ctx, "/issueLink/10")
+
+	want := []string{
+		`PUT /rest/api/3/issue/TEST-1 {"fields":{}}`,
+		"DELETE /rest/api/3/issueLink/10 ",
+	}
+	if strings.Join(recorded, "\n") != strings.Join(want, "\n") {
+		t.Errorf("unexpected recorded requests: %q", recorded)
+	}
+}
+
 func TestClient_APIError_WithMessages(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
@@ -126,6 +158,31 @@ func TestClient_APIError_WithMessages(t *testing.T) {
 	}
 }
 
+func TestClient_ServiceDeskError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/servicedeskapi/servicedesk" {
+			t.Errorf("expected service desk path, got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"errorMessage": "You are not an agent on this service desk",
+		})
+	}))
+	defer server.Close()
+
+	client := NewClient(testConfig(server.URL))
+	_, err := client.ServiceDeskGet(context.Background(), "/servicedesk")
+
+	apiErr, ok := err.(*APIError)
+	if !ok {
+		t.Fatalf("expected *APIError, got %T", err)
+	}
+	if !strings.Contains(apiErr.Error(), "not an agent") {
+		t.Errorf("expected service desk message in error, got: %s", apiErr.Error())
+	}
+}
+
 func TestClient_APIError_WithFieldErrors(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
@@ -0,0 +1,353 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/grantcarthew/ajira/internal/config"
+)
+
+// PolicyError is returned, without sending the request, when the policy
+// does not allow a change.
+type PolicyError struct {
+	Method string
+	Path   string
+	Reason string
+}
+
+func (e *PolicyError) Error() string {
+	return fmt.Sprintf("blocked by policy: %s (%s %s)", e.Reason, e.Method, e.Path)
+}
+
+var (
+	// policy is checked before each mutating request (nil = allow all).
+	policy *config.Policy
+	// policyCommand is the command making requests, such as "issue edit".
+	policyCommand string
+	// policyIssues holds the issues changed so far, for the batch limit.
+	policyIssues map[string]bool
+)
+
+// SetPolicy enforces p on every POST, PUT, and DELETE request made for
+// command.
+func SetPolicy(p *config.Policy, command string) {
+	policy = p
+	policyCommand = command
+	policyIssues = make(map[string]bool)
+}
+
+type requestContextKey int
+
+const transitionKey requestContextKey = iota
+
+// readPaths match the POST endpoints that only return data. Requests to them
+// are neither checked by the policy nor recorded.
+var readPaths = []*regexp.Regexp{
+	regexp.MustCompile(`^/rest/api/3/field/[^/]+/context/mapping$`),
+}
+
+// isReadRequest reports whether a request only reads data.
+func isReadRequest(method, path string) bool {
+	if method == http.MethodGet {
+		return true
+	}
+	path, _, _ = strings.Cut(path, "?")
+	return method == http.MethodPost && slices.ContainsFunc(readPaths, func(re *regexp.Regexp) bool {
+		return re.MatchString(path)
+	})
+}
+
+// WithTransition names the transition made with ctx, by transition and
+// target status name, for the transition allowlist.
+func WithTransition(ctx context.Context, names ...string) context.Context {
+	return context.WithValue(ctx, transitionKey, names)
+}
+
+// CheckBatch returns a PolicyError if changing n issues in one command
+// exceeds the batch limit. Batch commands call it before starting, so a
+// batch is refused whole rather than stopped partway.
+func CheckBatch(n int) error {
+	if policy == nil || policy.MaxBatch == 0 || n <= policy.MaxBatch {
+		return nil
+	}
+	return &PolicyError{
+		Method: "batch",
+		Path:   policyCommand,
+		Reason: fmt.Sprintf("%d issues exceed the limit of %d per command (AJIRA_MAX_BATCH)", n, policy.MaxBatch),
+	}
+}
+
+// checkPolicy returns a PolicyError if the policy does not allow a request.
+func (c *Client) checkPolicy(ctx context.Context, method, path string, body []byte) error {
+	if policy == nil || !policy.Active() || isReadRequest(method, path) {
+		return nil
+	}
+	deny := func(format string, args ...any) error {
+		return &PolicyError{Method: method, Path: path, Reason: fmt.Sprintf(format, args...)}
+	}
+
+	if policy.ReadOnly {
+		return deny("read-only mode (AJIRA_READONLY)")
+	}
+	if policy.NoDelete && method == http.MethodDelete {
+		return deny("deletes are not allowed (AJIRA_NO_DELETE)")
+	}
+	if len(policy.Commands) > 0 && !commandAllowed(policy.Commands, policyCommand) {
+		return deny("command %q is not allowed (AJIRA_ALLOW_COMMANDS)", policyCommand)
+	}
+	if len(policy.Transitions) > 0 && strings.HasSuffix(path, "/transitions") {
+		names, _ := ctx.Value(transitionKey).([]string)
+		if !slices.ContainsFunc(names, func(n string) bool {
+			return slices.ContainsFunc(policy.Transitions, func(a string) bool { return strings.EqualFold(a, n) })
+		}) {
+			return deny("transition %s is not allowed (AJIRA_ALLOW_TRANSITIONS)", strings.Join(names, " -> "))
+		}
+	}
+	if len(policy.Transitions) > 0 && method == http.MethodPost && path == basePathV3+"/bulk/issues/move" {
+		status, err := c.disallowedMoveStatus(ctx, body)
+		if err != nil {
+			return deny("target status cannot be determined (AJIRA_ALLOW_TRANSITIONS)")
+		}
+		if status != "" {
+			return deny("move to status %s is not allowed (AJIRA_ALLOW_TRANSITIONS)", status)
+		}
+	}
+
+	issues, projects := requestScope(path, body)
+	if len(policy.Projects) > 0 {
+		if len(projects) == 0 {
+			if project := c.lookupProject(ctx, method, path, body); project != "" {
+				projects = []string{project}
+			}
+		}
+		if len(projects) == 0 {
+			return deny("project cannot be determined (AJIRA_ALLOW_PROJECTS)")
+		}
+		for _, p := range projects {
+			if !slices.Contains(policy.Projects, p) {
+				return deny("project %s is not allowed (AJIRA_ALLOW_PROJECTS)", p)
+			}
+		}
+	}
+	if policy.MaxBatch > 0 {
+		if len(issues) == 0 {
+			// Changes without an issue key, such as creates, count once each
+			issues = []string{fmt.Sprintf("request-%d", len(policyIssues))}
+		}
+		var added []string
+		for _, key := range issues {
+			if !policyIssues[key] {
+				added = append(added, key)
+			}
+		}
+		if len(policyIssues)+len(added) > policy.MaxBatch {
+			return deny("more than %d issues changed by one command (AJIRA_MAX_BATCH)", policy.MaxBatch)
+		}
+		for _, key := range added {
+			policyIssues[key] = true
+		}
+	}
+
+	return nil
+}
+
+// commandAllowed reports whether command is in allowed, directly or through
+// a parent command such as "issue comment".
+func commandAllowed(allowed []string, command string) bool {
+	for _, a := range allowed {
+		if strings.EqualFold(command, a) || strings.HasPrefix(strings.ToLower(command), strings.ToLower(a)+" ") {
+			return true
+		}
+	}
+	return false
+}
+
+var issueKeyPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*-[0-9]+$`)
+
+// requestScope returns the issue keys and project keys a request changes,
+// from its path and the issue and project fields of its body.
+func requestScope(path string, body []byte) (issues, projects []string) {
+	addIssue := func(key string) {
+		if !issueKeyPattern.MatchString(key) {
+			return
+		}
+		key = strings.ToUpper(key)
+		if !slices.Contains(issues, key) {
+			issues = append(issues, key)
+		}
+	}
+	addProject := func(key string) {
+		key = strings.ToUpper(key)
+		if key != "" && !slices.Contains(projects, key) {
+			projects = append(projects, key)
+		}
+	}
+
+	path, _, _ = strings.Cut(path, "?")
+	for _, segment := range strings.Split(path, "/") {
+		addIssue(segment)
+	}
+
+	var fields map[string]json.RawMessage
+	if json.Unmarshal(body, &fields) == nil {
+		var keys []string
+		if json.Unmarshal(fields["issues"], &keys) == nil {
+			for _, key := range keys {
+				addIssue(key)
+			}
+		}
+		for _, name := range []string{"rankBeforeIssue", "rankAfterIssue"} {
+			var key string
+			if json.Unmarshal(fields[name], &key) == nil {
+				addIssue(key)
+			}
+		}
+		for _, name := range []string{"outwardIssue", "inwardIssue"} {
+			var ref struct {
+				Key string `json:"key"`
+			}
+			if json.Unmarshal(fields[name], &ref) == nil {
+				addIssue(ref.Key)
+			}
+		}
+
+		// Bulk moves key targets by "<project>,<issueTypeId>"
+		var targets map[string]struct {
+			IssueIdsOrKeys []string `json:"issueIdsOrKeys"`
+		}
+		if json.Unmarshal(fields["targetToSourcesMapping"], &targets) == nil {
+			for target, sources := range targets {
+				project, _, _ := strings.Cut(target, ",")
+				addProject(project)
+				for _, key := range sources.IssueIdsOrKeys {
+					addIssue(key)
+				}
+			}
+		}
+
+		var project string
+		if json.Unmarshal(fields["project"], &project) == nil {
+			addProject(project)
+		}
+		var issueFields struct {
+			Project struct {
+				Key string `json:"key"`
+			} `json:"project"`
+		}
+		if json.Unmarshal(fields["fields"], &issueFields) == nil {
+			addProject(issueFields.Project.Key)
+		}
+	}
+
+	for _, key := range issues {
+		addProject(key[:strings.LastIndex(key, "-")])
+	}
+	return issues, projects
+}
+
+var componentPathPattern = regexp.MustCompile(`^/rest/api/3/component/([^/?]+)`)
+
+// lookupProject fetches the project of a request that names it only by ID:
+// component edits and deletes, and service desk requests. It returns "" if
+// the project cannot be found.
+func (c *Client) lookupProject(ctx context.Context, method, path string, body []byte) string {
+	if m := componentPathPattern.FindStringSubmatch(path); m != nil && (method == http.MethodPut || method == http.MethodDelete) {
+		var component struct {
+			Project string `json:"project"`
+		}
+		if c.getJSON(ctx, basePathV3+"/component/"+m[1], &component) != nil {
+			return ""
+		}
+		return strings.ToUpper(component.Project)
+	}
+
+	if method == http.MethodPost && path == basePathServiceDesk+"/request" {
+		var req struct {
+			ServiceDeskID string `json:"serviceDeskId"`
+		}
+		if json.Unmarshal(body, &req) != nil || req.ServiceDeskID == "" {
+			return ""
+		}
+		var desk struct {
+			ProjectKey string `json:"projectKey"`
+		}
+		if c.getJSON(ctx, basePathServiceDesk+"/servicedesk/"+url.PathEscape(req.ServiceDeskID), &desk) != nil {
+			return ""
+		}
+		return strings.ToUpper(desk.ProjectKey)
+	}
+
+	return ""
+}
+
+// disallowedMoveStatus returns the first status a bulk move maps issues into
+// that the transition allowlist does not name, or "" if there is none.
+// Statuses keeping their name, as when the target workflow shares it, are
+// not a transition.
+func (c *Client) disallowedMoveStatus(ctx context.Context, body []byte) (string, error) {
+	var req struct {
+		TargetToSourcesMapping map[string]struct {
+			TargetStatus []struct {
+				Statuses map[string][]struct {
+					StatusID string `json:"statusId"`
+				} `json:"statuses"`
+			} `json:"targetStatus"`
+		} `json:"targetToSourcesMapping"`
+	}
+	if err := json.Unmarshal(body, &req); err != nil {
+		return "", err
+	}
+
+	names := make(map[string]string)
+	statusName := func(id string) (string, error) {
+		if name, ok := names[id]; ok {
+			return name, nil
+		}
+		var status struct {
+			Name string `json:"name"`
+		}
+		if err := c.getJSON(ctx, basePathV3+"/status/"+url.PathEscape(id), &status); err != nil {
+			return "", err
+		}
+		names[id] = status.Name
+		return status.Name, nil
+	}
+
+	for _, target := range req.TargetToSourcesMapping {
+		for _, mapping := range target.TargetStatus {
+			for targetID, sources := range mapping.Statuses {
+				name, err := statusName(targetID)
+				if err != nil {
+					return "", err
+				}
+				if slices.ContainsFunc(policy.Transitions, func(a string) bool { return strings.EqualFold(a, name) }) {
+					continue
+				}
+				for _, source := range sources {
+					from, err := statusName(source.StatusID)
+					if err != nil {
+						return "", err
+					}
+					if !strings.EqualFold(from, name) {
+						return name, nil
+					}
+				}
+			}
+		}
+	}
+	return "", nil
+}
+
+func (c *Client) getJSON(ctx context.Context, path string, v any) error {
+	body, err := c.doRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, v)
+}
@@ -0,0 +1,153 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/grantcarthew/ajira/internal/config"
+)
+
+func TestCheckPolicy(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name    string
+		policy  config.Policy
+		command string
+		ctx     context.Context
+		method  string
+		path    string
+		body    string
+		wantErr string
+	}{
+		{name: "read-only blocks change", policy: config.Policy{ReadOnly: true}, method: "PUT", path: "/rest/api/3/issue/A-1", wantErr: "read-only"},
+		{name: "read-only allows read", policy: config.Policy{ReadOnly: true}, method: "GET", path: "/rest/api/3/issue/A-1"},
+		{name: "read-only allows POST read", policy: config.Policy{ReadOnly: true}, method: "POST", path: "/rest/api/3/field/f/context/mapping"},
+		{name: "no delete ignores POST read", policy: config.Policy{NoDelete: true, Projects: []string{"A"}}, method: "POST", path: "/rest/api/3/field/f/context/mapping"},
+		{name: "no delete", policy: config.Policy{NoDelete: true}, method: "DELETE", path: "/rest/api/3/issue/A-1", wantErr: "deletes"},
+		{name: "no delete allows edit", policy: config.Policy{NoDelete: true}, method: "PUT", path: "/rest/api/3/issue/A-1"},
+		{name: "command allowed by parent", policy: config.Policy{Commands: []string{"issue comment"}}, command: "issue comment add", method: "POST", path: "/rest/api/3/issue/A-1/comment"},
+		{name: "command not allowed", policy: config.Policy{Commands: []string{"issue comment"}}, command: "issue delete", method: "DELETE", path: "/rest/api/3/issue/A-1", wantErr: `command "issue delete"`},
+		{name: "transition allowed by status", policy: config.Policy{Transitions: []string{"in progress"}}, ctx: WithTransition(ctx, "Start", "In Progress"), method: "POST", path: "/rest/api/3/issue/A-1/transitions"},
+		{name: "transition not allowed", policy: config.Policy{Transitions: []string{"In Progress"}}, ctx: WithTransition(ctx, "Close", "Done"), method: "POST", path: "/rest/api/3/issue/A-1/transitions", wantErr: "transition Close -> Done"},
+		{name: "unnamed transition not allowed", policy: config.Policy{Transitions: []string{"Done"}}, method: "POST", path: "/rest/api/3/issue/A-1/transitions", wantErr: "transition"},
+		{name: "project from path", policy: config.Policy{Projects: []string{"A"}}, method: "PUT", path: "/rest/api/3/issue/a-1"},
+		{name: "project not allowed", policy: config.Policy{Projects: []string{"A"}}, method: "PUT", path: "/rest/api/3/issue/B-1", wantErr: "project B"},
+		{name: "project from create body", policy: config.Policy{Projects: []string{"A"}}, method: "POST", path: "/rest/api/3/issue", body: `{"fields": {"project": {"key": "B"}}}`, wantErr: "project B"},
+		{name: "project from link body", policy: config.Policy{Projects: []string{"A"}}, method: "POST", path: "/rest/api/3/issueLink", body: `{"outwardIssue": {"key": "A-2"}, "inwardIssue": {"key": "B-1"}}`, wantErr: "project B"},
+		{name: "project from agile body", policy: config.Policy{Projects: []string{"A"}}, method: "POST", path: "/rest/agile/1.0/sprint/5/issue", body: `{"issues": ["A-1", "A-2"]}`},
+		{name: "relocate to allowed project", policy: config.Policy{Projects: []string{"A"}}, method: "POST", path: "/rest/api/3/bulk/issues/move", body: `{"targetToSourcesMapping": {"A,10": {"issueIdsOrKeys": ["A-1"]}}}`},
+		{name: "relocate to other project", policy: config.Policy{Projects: []string{"A"}}, method: "POST", path: "/rest/api/3/bulk/issues/move", body: `{"targetToSourcesMapping": {"B,10": {"issueIdsOrKeys": ["A-1"]}}}`, wantErr: "project B"},
+		{name: "relocate from other project", policy: config.Policy{Projects: []string{"A"}}, method: "POST", path: "/rest/api/3/bulk/issues/move", body: `{"targetToSourcesMapping": {"A,10": {"issueIdsOrKeys": ["B-1"]}}}`, wantErr: "project B"},
+		{name: "relocate into allowed status", policy: config.Policy{Transitions: []string{"in progress"}}, method: "POST", path: "/rest/api/3/bulk/issues/move", body: `{"targetToSourcesMapping": {"A,10": {"targetStatus": [{"statuses": {"40": [{"statusId": "31"}]}}]}}}`},
+		{name: "relocate into other status", policy: config.Policy{Transitions: []string{"In Progress"}}, method: "POST", path: "/rest/api/3/bulk/issues/move", body: `{"targetToSourcesMapping": {"A,10": {"targetStatus": [{"statuses": {"41": [{"statusId": "31"}]}}]}}}`, wantErr: "move to status Done"},
+		{name: "relocate keeping status name", policy: config.Policy{Transitions: []string{"In Progress"}}, method: "POST", path: "/rest/api/3/bulk/issues/move", body: `{"targetToSourcesMapping": {"A,10": {"targetStatus": [{"statuses": {"41": [{"statusId": "32"}]}}]}}}`},
+		{name: "relocate into unknown status", policy: config.Policy{Transitions: []string{"In Progress"}}, method: "POST", path: "/rest/api/3/bulk/issues/move", body: `{"targetToSourcesMapping": {"A,10": {"targetStatus": [{"statuses": {"99": [{"statusId": "31"}]}}]}}}`, wantErr: "target status cannot be determined"},
+		{name: "component in allowed project", policy: config.Policy{Projects: []string{"A"}}, method: "PUT", path: "/rest/api/3/component/200", body: `{"name": "API"}`},
+		{name: "component in other project", policy: config.Policy{Projects: []string{"A"}}, method: "DELETE", path: "/rest/api/3/component/100?moveIssuesTo=101", wantErr: "project B"},
+		{name: "component not found", policy: config.Policy{Projects: []string{"A"}}, method: "DELETE", path: "/rest/api/3/component/999", wantErr: "cannot be determined"},
+		{name: "service desk request in allowed project", policy: config.Policy{Projects: []string{"A"}}, method: "POST", path: "/rest/servicedeskapi/request", body: `{"serviceDeskId": "5", "requestTypeId": "1"}`},
+		{name: "service desk request in other project", policy: config.Policy{Projects: []string{"A"}}, method: "POST", path: "/rest/servicedeskapi/request", body: `{"serviceDeskId": "6", "requestTypeId": "1"}`, wantErr: "project B"},
+		{name: "project unknown", policy: config.Policy{Projects: []string{"A"}}, method: "DELETE", path: "/rest/api/3/attachment/100", wantErr: "cannot be determined"},
+		{name: "batch limit in one request", policy: config.Policy{MaxBatch: 1}, method: "POST", path: "/rest/agile/1.0/sprint/5/issue", body: `{"issues": ["A-1", "A-2"]}`, wantErr: "more than 1 issues"},
+	}
+
+	// Lookups for requests that name their project or status only by ID
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+		}
+		switch r.URL.Path {
+		case "/rest/api/3/component/100":
+			w.Write([]byte(`{"id": "100", "project": "B"}`))
+		case "/rest/api/3/component/200":
+			w.Write([]byte(`{"id": "200", "project": "A"}`))
+		case "/rest/servicedeskapi/servicedesk/5":
+			w.Write([]byte(`{"id": "5", "projectKey": "A"}`))
+		case "/rest/servicedeskapi/servicedesk/6":
+			w.Write([]byte(`{"id": "6", "projectKey": "B"}`))
+		case "/rest/api/3/status/31":
+			w.Write([]byte(`{"id": "31", "name": "Doing"}`))
+		case "/rest/api/3/status/32", "/rest/api/3/status/41":
+			w.Write([]byte(`{"name": "Done"}`))
+		case "/rest/api/3/status/40":
+			w.Write([]byte(`{"id": "40", "name": "In Progress"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	client := NewClient(testConfig(server.URL))
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetPolicy(&tt.policy, tt.command)
+			defer SetPolicy(nil, "")
+
+			reqCtx := tt.ctx
+			if reqCtx == nil {
+				reqCtx = ctx
+			}
+			err := client.checkPolicy(reqCtx, tt.method, tt.path, []byte(tt.body))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			var policyErr *PolicyError
+			if !errors.As(err, &policyErr) || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected policy error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestCheckPolicy_BatchAcrossRequests(t *testing.T) {
+	SetPolicy(&config.Policy{MaxBatch: 2}, "issue assign")
+	defer SetPolicy(nil, "")
+
+	client := NewClient(testConfig("https://example.atlassian.net"))
+	ctx := context.Background()
+	for _, path := range []string{"/rest/api/3/issue/A-1/assignee", "/rest/api/3/issue/A-2/assignee", "/rest/api/3/issue/A-1"} {
+		if err := client.checkPolicy(ctx, "PUT", path, nil); err != nil {
+			t.Fatalf("%s: unexpected error: %v", path, err)
+		}
+	}
+	if err := client.checkPolicy(ctx, "PUT", "/rest/api/3/issue/A-3/assignee", nil); err == nil {
+		t.Fatal("expected third issue to exceed the batch limit")
+	}
+
+	if err := CheckBatch(2); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := CheckBatch(3); err == nil || !strings.Contains(err.Error(), "3 issues exceed the limit of 2") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestClient_PolicyBlocksRequest(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	SetPolicy(&config.Policy{ReadOnly: true}, "issue delete")
+	defer SetPolicy(nil, "")
+
+	client := NewClient(testConfig(server.URL))
+	if _, err := client.Delete(context.Background(), "/issue/A-1"); err == nil {
+		t.Fatal("expected policy error")
+	}
+	if _, err := client.PostMultipart(context.Background(), "/issue/A-1/attachments", "multipart/form-data", strings.NewReader(""), 0); err == nil {
+		t.Fatal("expected policy error for upload")
+	}
+	if calls != 0 {
+		t.Errorf("expected no requests to be sent, got %d", calls)
+	}
+}
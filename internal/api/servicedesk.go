@@ -0,0 +1,18 @@
+package api
+
+import (
+	"context"
+	"net/http"
+)
+
+const basePathServiceDesk = "/rest/servicedeskapi"
+
+// ServiceDeskGet performs a GET request to the Jira Service Management API.
+func (c *Client) ServiceDeskGet(ctx context.Context, path string) ([]byte, error) {
+	return c.doRequest(ctx, http.MethodGet, basePathServiceDesk+path, nil)
+}
+
+// ServiceDeskPost performs a POST request to the Jira Service Management API.
+func (c *Client) ServiceDeskPost(ctx context.Context, path string, body []byte) ([]byte, error) {
+	return c.doRequest(ctx, http.MethodPost, basePathServiceDesk+path, body)
+}
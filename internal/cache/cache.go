@@ -0,0 +1,94 @@
+// Package cache keeps short-lived lists of values, such as shell completion
+// suggestions, in small JSON files so repeated lookups skip the network.
+//
+// Entries are keyed by an arbitrary string that is hashed into a file name.
+// Stale or unreadable entries are treated as missing; the cache is never
+// the source of truth.
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+type entry struct {
+	Time   time.Time `json:"time"`
+	Values []string  `json:"values"`
+}
+
+// Dir returns the cache directory. AJIRA_CACHE overrides the default of
+// ajira under the user cache directory ($XDG_CACHE_HOME or ~/.cache on
+// Linux). An empty path means caching is disabled with AJIRA_CACHE=off.
+func Dir() (string, error) {
+	if d := os.Getenv("AJIRA_CACHE"); d != "" {
+		if strings.EqualFold(d, "off") {
+			return "", nil
+		}
+		return d, nil
+	}
+
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("locating cache: %w", err)
+	}
+	return filepath.Join(dir, "ajira"), nil
+}
+
+// Load returns the values stored for key in dir if they are younger than
+// ttl.
+func Load(dir, key string, ttl time.Duration) ([]string, bool) {
+	data, err := os.ReadFile(filePath(dir, key))
+	if err != nil {
+		return nil, false
+	}
+
+	var e entry
+	if err := json.Unmarshal(data, &e); err != nil || time.Since(e.Time) > ttl {
+		return nil, false
+	}
+	return e.Values, true
+}
+
+// Store saves values for key in dir, replacing any earlier entry.
+func Store(dir, key string, values []string) error {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("creating cache directory: %w", err)
+	}
+
+	data, err := json.Marshal(entry{Time: time.Now(), Values: values})
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file and rename it, so concurrent completions
+	// never read a partial entry
+	tmp, err := os.CreateTemp(dir, ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("writing cache: %w", err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return fmt.Errorf("writing cache: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("writing cache: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), filePath(dir, key)); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("writing cache: %w", err)
+	}
+	return nil
+}
+
+func filePath(dir, key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(dir, hex.EncodeToString(sum[:12])+".json")
+}
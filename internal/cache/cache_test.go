@@ -0,0 +1,65 @@
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDir(t *testing.T) {
+	t.Setenv("AJIRA_CACHE", "/tmp/ajira-cache")
+	if d, _ := Dir(); d != "/tmp/ajira-cache" {
+		t.Errorf("unexpected override dir: %s", d)
+	}
+
+	t.Setenv("AJIRA_CACHE", "off")
+	if d, _ := Dir(); d != "" {
+		t.Errorf("expected cache off, got %s", d)
+	}
+
+	t.Setenv("AJIRA_CACHE", "")
+	t.Setenv("XDG_CACHE_HOME", "/tmp/xdg-cache")
+	if d, _ := Dir(); !strings.HasSuffix(d, "ajira") {
+		t.Errorf("unexpected default dir: %s", d)
+	}
+}
+
+func TestStoreLoad(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "nested")
+
+	if _, ok := Load(dir, "priorities", time.Minute); ok {
+		t.Fatal("expected miss on empty cache")
+	}
+
+	if err := Store(dir, "priorities", []string{"High", "Low"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	values, ok := Load(dir, "priorities", time.Minute)
+	if !ok || strings.Join(values, ",") != "High,Low" {
+		t.Errorf("unexpected values: %v, %v", values, ok)
+	}
+	if _, ok := Load(dir, "statuses", time.Minute); ok {
+		t.Error("expected miss for another key")
+	}
+	if _, ok := Load(dir, "priorities", 0); ok {
+		t.Error("expected expired entry to miss")
+	}
+
+	files, _ := os.ReadDir(dir)
+	if len(files) != 1 {
+		t.Errorf("expected one cache file, got %d", len(files))
+	}
+}
+
+func TestLoad_Corrupt(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filePath(dir, "types"), []byte("{not json"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := Load(dir, "types", time.Minute); ok {
+		t.Error("expected corrupt entry to miss")
+	}
+}
@@ -0,0 +1,18 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var backlogCmd = &cobra.Command{
+	Use:   "backlog",
+	Short: "Manage the board backlog",
+	Long:  "Commands for working with a Jira board backlog.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(backlogCmd)
+}
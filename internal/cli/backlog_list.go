@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/grantcarthew/ajira/internal/api"
+	"github.com/grantcarthew/ajira/internal/config"
+	"github.com/spf13/cobra"
+)
+
+var backlogListLimit int
+
+var backlogListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List backlog issues",
+	Long:    "List issues in a board's backlog in rank order. Requires --board or JIRA_BOARD.",
+	Example: `  ajira backlog list                   # Top 50 backlog issues
+  ajira backlog list -l 0              # Entire backlog
+  ajira backlog list --board 1342 --json`,
+	SilenceUsage: true,
+	RunE:         runBacklogList,
+}
+
+func init() {
+	backlogListCmd.Flags().IntVarP(&backlogListLimit, "limit", "l", 50, "Maximum issues to return (0 = all)")
+
+	backlogCmd.AddCommand(backlogListCmd)
+}
+
+func runBacklogList(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	boardID := Board()
+	if boardID == "" {
+		return fmt.Errorf("board ID required; use --board flag or set JIRA_BOARD")
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(cfg)
+
+	issues, err := listBacklog(ctx, client, boardID, backlogListLimit)
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); ok {
+			return fmt.Errorf("API error: %w", apiErr)
+		}
+		return fmt.Errorf("failed to list backlog: %w", err)
+	}
+
+	if JSONOutput() {
+		output, err := json.MarshalIndent(issues, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to format JSON: %w", err)
+		}
+		fmt.Println(string(output))
+	} else {
+		printIssueList(issues)
+	}
+
+	return nil
+}
+
+// listBacklog returns a board's backlog issues in rank order.
+func listBacklog(ctx context.Context, client *api.Client, boardID string, limit int) ([]IssueInfo, error) {
+	values, err := fetchAgileIssues(ctx, client, fmt.Sprintf("/board/%s/backlog", url.PathEscape(boardID)), issueListFields, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	issues := make([]IssueInfo, len(values))
+	for i, v := range values {
+		issues[i] = issueInfoFromValue(v)
+	}
+	return issues, nil
+}
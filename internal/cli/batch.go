@@ -3,9 +3,12 @@ package cli
 import (
 	"bufio"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
+
+	"github.com/grantcarthew/ajira/internal/api"
 )
 
 // BatchResult represents the outcome of a single batch operation.
@@ -13,6 +16,12 @@ type BatchResult struct {
 	Key     string `json:"key"`
 	Success bool   `json:"success"`
 	Error   string `json:"error,omitempty"`
+	err     error  // The failure, kept to pick the exit code
+}
+
+// failedResult returns the result of an operation on key that failed with err.
+func failedResult(key string, err error) BatchResult {
+	return BatchResult{Key: key, Success: false, Error: err.Error(), err: err}
 }
 
 // BatchSummary represents the overall batch operation outcome.
@@ -39,8 +48,18 @@ func ReadKeysFromStdin() ([]string, error) {
 	return keys, nil
 }
 
+// checkBatchPolicy refuses a batch of n issues that exceeds AJIRA_MAX_BATCH
+// before any change is made. Dry runs are not limited.
+func checkBatchPolicy(n int) error {
+	if DryRun() {
+		return nil
+	}
+	return api.CheckBatch(n)
+}
+
 // PrintBatchResults prints batch operation results.
-// Returns an ExitError with ExitPartial if there were any failures.
+// Returns an ExitError with ExitPartial if there were any failures, or
+// ExitPolicyError if any were blocked by the policy.
 func PrintBatchResults(results []BatchResult) error {
 	summary := BatchSummary{
 		Results: results,
@@ -76,6 +95,12 @@ func PrintBatchResults(results []BatchResult) error {
 	}
 
 	if summary.Failed > 0 {
+		for _, r := range results {
+			var policyErr *api.PolicyError
+			if errors.As(r.err, &policyErr) {
+				return NewExitError(ExitPolicyError, fmt.Errorf("%d of %d operations failed, some blocked by policy", summary.Failed, summary.Total))
+			}
+		}
 		if summary.Succeeded > 0 {
 			return NewExitError(ExitPartial, fmt.Errorf("partial failure: %d of %d failed", summary.Failed, summary.Total))
 		}
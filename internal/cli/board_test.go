@@ -165,3 +165,111 @@ func TestListBoards_APIError(t *testing.T) {
 		t.Errorf("expected status 403, got %d", apiErr.StatusCode)
 	}
 }
+
+func TestGetBoardView_GroupsByColumn(t *testing.T) {
+	maxWIP := 1
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/agile/1.0/board/1342/configuration":
+			_ = json.NewEncoder(w).Encode(boardConfigResponse{
+				ID:   1342,
+				Name: "GCP Board",
+				Type: "kanban",
+				ColumnConfig: boardColumnConfig{Columns: []boardColumnValue{
+					{Name: "To Do", Statuses: []boardColumnStatus{{ID: "1"}}},
+					{Name: "In Progress", Statuses: []boardColumnStatus{{ID: "3"}, {ID: "4"}}, Max: &maxWIP},
+					{Name: "Done", Statuses: []boardColumnStatus{{ID: "10"}}},
+				}},
+			})
+		case "/rest/agile/1.0/board/1342/issue":
+			_ = json.NewEncoder(w).Encode(agileIssueResponse{
+				Total: 4,
+				Issues: []issueValue{
+					{Key: "GCP-1", Fields: issueFields{Status: &statusField{ID: "1"}}},
+					{Key: "GCP-2", Fields: issueFields{Status: &statusField{ID: "3"}}},
+					{Key: "GCP-3", Fields: issueFields{Status: &statusField{ID: "4"}}},
+					{Key: "GCP-4", Fields: issueFields{Status: &statusField{ID: "99"}}},
+				},
+			})
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	view, err := getBoardView(context.Background(), client, "1342", 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(view.Columns) != 4 {
+		t.Fatalf("expected 3 columns plus Unmapped, got %d", len(view.Columns))
+	}
+
+	inProgress := view.Columns[1]
+	if inProgress.Count != 2 || !inProgress.OverLimit {
+		t.Errorf("expected In Progress with 2 issues over limit, got %+v", inProgress)
+	}
+	if view.Columns[2].Count != 0 || view.Columns[2].Issues == nil {
+		t.Errorf("expected empty Done column with non-nil issues, got %+v", view.Columns[2])
+	}
+	if view.Columns[3].Name != "Unmapped" || view.Columns[3].Issues[0].Key != "GCP-4" {
+		t.Errorf("expected GCP-4 in Unmapped column, got %+v", view.Columns[3])
+	}
+}
+
+func TestFetchAgileIssues_Pagination(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		resp := agileIssueResponse{Total: 3}
+		if r.URL.Query().Get("startAt") == "0" {
+			resp.Issues = []issueValue{{Key: "GCP-1"}, {Key: "GCP-2"}}
+		} else {
+			resp.Issues = []issueValue{{Key: "GCP-3"}}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	issues, err := fetchAgileIssues(context.Background(), client, "/board/1/issue", issueListFields, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(issues) != 3 {
+		t.Errorf("expected 3 issues, got %d", len(issues))
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+}
+
+func TestListBacklog_Success(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/agile/1.0/board/1342/backlog" {
+			t.Errorf("expected backlog path, got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(agileIssueResponse{
+			Total:  2,
+			Issues: []issueValue{{Key: "GCP-2"}, {Key: "GCP-1"}},
+		})
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	issues, err := listBacklog(context.Background(), client, "1342", 50)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(issues) != 2 || issues[0].Key != "GCP-2" {
+		t.Errorf("expected backlog in rank order, got %+v", issues)
+	}
+}
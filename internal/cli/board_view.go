@@ -0,0 +1,299 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/fatih/color"
+	"github.com/grantcarthew/ajira/internal/api"
+	"github.com/grantcarthew/ajira/internal/config"
+	"github.com/grantcarthew/ajira/internal/width"
+	"github.com/spf13/cobra"
+)
+
+// BoardView represents a board's columns and their issues for output.
+type BoardView struct {
+	ID      int           `json:"id"`
+	Name    string        `json:"name"`
+	Type    string        `json:"type"`
+	Columns []BoardColumn `json:"columns"`
+}
+
+// BoardColumn represents a single board column with WIP information.
+type BoardColumn struct {
+	Name      string      `json:"name"`
+	Count     int         `json:"count"`
+	Min       *int        `json:"min,omitempty"`
+	Max       *int        `json:"max,omitempty"`
+	OverLimit bool        `json:"overLimit"`
+	Issues    []IssueInfo `json:"issues"`
+}
+
+// boardConfigResponse matches the Jira Agile board configuration API response.
+type boardConfigResponse struct {
+	ID           int               `json:"id"`
+	Name         string            `json:"name"`
+	Type         string            `json:"type"`
+	ColumnConfig boardColumnConfig `json:"columnConfig"`
+}
+
+type boardColumnConfig struct {
+	Columns []boardColumnValue `json:"columns"`
+}
+
+type boardColumnValue struct {
+	Name     string              `json:"name"`
+	Statuses []boardColumnStatus `json:"statuses"`
+	Min      *int                `json:"min,omitempty"`
+	Max      *int                `json:"max,omitempty"`
+}
+
+type boardColumnStatus struct {
+	ID string `json:"id"`
+}
+
+// agileIssueResponse matches the paginated issue responses of the Agile API
+// (board, backlog, and sprint issue endpoints).
+type agileIssueResponse struct {
+	StartAt    int          `json:"startAt"`
+	MaxResults int          `json:"maxResults"`
+	Total      int          `json:"total"`
+	Issues     []issueValue `json:"issues"`
+}
+
+var boardViewLimit int
+
+var boardViewCmd = &cobra.Command{
+	Use:   "view [board-id]",
+	Short: "View board columns and issues",
+	Long:  "Display a board's issues grouped by column, with WIP counts and limits. Uses --board or JIRA_BOARD when no ID is given.",
+	Example: `  ajira board view 1342               # View a specific board
+  ajira board view                    # View the default board (JIRA_BOARD)
+  ajira board view -l 200 --json      # Structured output with WIP limits`,
+	Args:              cobra.MaximumNArgs(1),
+	SilenceUsage:      true,
+	ValidArgsFunction: completeFirstArg(completeBoards),
+	RunE:              runBoardView,
+}
+
+func init() {
+	boardViewCmd.Flags().IntVarP(&boardViewLimit, "limit", "l", 0, "Maximum issues to fetch (0 = all)")
+
+	boardCmd.AddCommand(boardViewCmd)
+}
+
+func runBoardView(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	boardID := Board()
+	if len(args) > 0 {
+		boardID = args[0]
+	}
+	if boardID == "" {
+		return fmt.Errorf("board ID required; pass an ID, use --board flag, or set JIRA_BOARD")
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(cfg)
+
+	view, err := getBoardView(ctx, client, boardID, boardViewLimit)
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); ok {
+			return fmt.Errorf("API error: %w", apiErr)
+		}
+		return fmt.Errorf("failed to view board: %w", err)
+	}
+
+	if JSONOutput() {
+		output, err := json.MarshalIndent(view, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to format JSON: %w", err)
+		}
+		fmt.Println(string(output))
+	} else {
+		printBoardView(view)
+	}
+
+	return nil
+}
+
+// getBoardView fetches the board's column configuration and issues, then
+// groups issues into columns by status ID. Issues whose status is not mapped
+// to any column are collected in a trailing "Unmapped" column.
+func getBoardView(ctx context.Context, client *api.Client, boardID string, limit int) (*BoardView, error) {
+	body, err := client.AgileGet(ctx, fmt.Sprintf("/board/%s/configuration", url.PathEscape(boardID)))
+	if err != nil {
+		return nil, err
+	}
+
+	var boardCfg boardConfigResponse
+	if err := json.Unmarshal(body, &boardCfg); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	issues, err := fetchAgileIssues(ctx, client, fmt.Sprintf("/board/%s/issue", url.PathEscape(boardID)), issueListFields, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return groupBoardIssues(boardCfg, issues), nil
+}
+
+func groupBoardIssues(boardCfg boardConfigResponse, issues []issueValue) *BoardView {
+	view := &BoardView{
+		ID:      boardCfg.ID,
+		Name:    boardCfg.Name,
+		Type:    boardCfg.Type,
+		Columns: make([]BoardColumn, len(boardCfg.ColumnConfig.Columns)),
+	}
+
+	columnByStatus := make(map[string]int)
+	for i, c := range boardCfg.ColumnConfig.Columns {
+		view.Columns[i] = BoardColumn{
+			Name:   c.Name,
+			Min:    c.Min,
+			Max:    c.Max,
+			Issues: []IssueInfo{},
+		}
+		for _, s := range c.Statuses {
+			columnByStatus[s.ID] = i
+		}
+	}
+
+	var unmapped []IssueInfo
+	for _, issue := range issues {
+		info := issueInfoFromValue(issue)
+		statusID := ""
+		if issue.Fields.Status != nil {
+			statusID = issue.Fields.Status.ID
+		}
+		if i, ok := columnByStatus[statusID]; ok {
+			view.Columns[i].Issues = append(view.Columns[i].Issues, info)
+		} else {
+			unmapped = append(unmapped, info)
+		}
+	}
+
+	if len(unmapped) > 0 {
+		view.Columns = append(view.Columns, BoardColumn{Name: "Unmapped", Issues: unmapped})
+	}
+
+	for i := range view.Columns {
+		c := &view.Columns[i]
+		c.Count = len(c.Issues)
+		c.OverLimit = c.Max != nil && *c.Max > 0 && c.Count > *c.Max
+	}
+
+	return view
+}
+
+// fetchAgileIssues pages through an Agile API issue endpoint such as
+// /board/{id}/issue, /board/{id}/backlog, or /sprint/{id}/issue, requesting
+// the given comma-separated fields.
+func fetchAgileIssues(ctx context.Context, client *api.Client, basePath, fields string, limit int) ([]issueValue, error) {
+	var allIssues []issueValue
+	maxResults := 50
+	if limit > 0 && limit < maxResults {
+		maxResults = limit
+	}
+
+	startAt := 0
+	const maxPages = 100
+
+	for range maxPages {
+		path := fmt.Sprintf("%s?fields=%s&maxResults=%d&startAt=%d", basePath, fields, maxResults, startAt)
+
+		body, err := client.AgileGet(ctx, path)
+		if err != nil {
+			return nil, err
+		}
+
+		var resp agileIssueResponse
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return nil, fmt.Errorf("failed to parse response: %w", err)
+		}
+
+		for _, issue := range resp.Issues {
+			allIssues = append(allIssues, issue)
+
+			if limit > 0 && len(allIssues) >= limit {
+				return allIssues[:limit], nil
+			}
+		}
+
+		startAt += len(resp.Issues)
+		if len(resp.Issues) == 0 || startAt >= resp.Total {
+			break
+		}
+	}
+
+	return allIssues, nil
+}
+
+func printBoardView(view *BoardView) {
+	bold := color.New(color.Bold).SprintFunc()
+	header := color.New(color.FgCyan, color.Bold).SprintFunc()
+	red := color.New(color.FgRed, color.Bold).SprintFunc()
+	faint := color.New(color.Faint).SprintFunc()
+
+	fmt.Printf("%s %s\n", bold(view.Name), faint(fmt.Sprintf("(%s, %d)", view.Type, view.ID)))
+
+	keyWidth, typeWidth := 3, 4
+	for _, c := range view.Columns {
+		for _, issue := range c.Issues {
+			if w := width.StringWidth(issue.Key); w > keyWidth {
+				keyWidth = w
+			}
+			if w := width.StringWidth(issue.Type); w > typeWidth {
+				typeWidth = w
+			}
+		}
+	}
+
+	for _, c := range view.Columns {
+		fmt.Println()
+
+		title := header(fmt.Sprintf("%s (%s)", c.Name, formatWIP(c)))
+		if c.OverLimit {
+			title += " " + red("OVER LIMIT")
+		}
+		fmt.Println(title)
+
+		if len(c.Issues) == 0 {
+			fmt.Println(faint("  No issues"))
+			continue
+		}
+
+		for _, issue := range c.Issues {
+			assignee := issue.Assignee
+			if assignee == "" {
+				assignee = "Unassigned"
+			}
+
+			fmt.Printf("  %s  %s  %s  %s\n",
+				bold(width.PadRight(issue.Key, keyWidth)),
+				width.PadRight(issue.Type, typeWidth),
+				width.PadRight(width.Truncate(issue.Summary, 50, "..."), 50),
+				faint(assignee))
+		}
+	}
+}
+
+// formatWIP renders a column's issue count with any configured limits,
+// e.g. "3", "3/5 max", or "1, min 2".
+func formatWIP(c BoardColumn) string {
+	s := fmt.Sprintf("%d", c.Count)
+	if c.Max != nil && *c.Max > 0 {
+		s = fmt.Sprintf("%d/%d max", c.Count, *c.Max)
+	}
+	if c.Min != nil && *c.Min > 0 {
+		s += fmt.Sprintf(", min %d", *c.Min)
+	}
+	return s
+}
@@ -0,0 +1,157 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/grantcarthew/ajira/internal/api"
+)
+
+// bulkConfirmThreshold is the number of issues matched by --jql above which
+// a change requires --yes. Dry runs are never blocked.
+const bulkConfirmThreshold = 50
+
+// IssuePreview lists the field changes a bulk operation would make to one issue.
+type IssuePreview struct {
+	Key     string        `json:"key"`
+	Summary string        `json:"summary"`
+	Changes []FieldChange `json:"changes"`
+}
+
+// FieldChange is a field value before and after a change, rendered as text.
+type FieldChange struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+// resolveJQLIssues returns every issue matching jql with the given fields.
+// Outside dry-run, more than bulkConfirmThreshold matches require confirmed.
+func resolveJQLIssues(ctx context.Context, client *api.Client, jql, fields string, confirmed bool) ([]issueValue, error) {
+	issues, err := searchAllIssueValues(ctx, client, jql, fields)
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); ok {
+			return nil, fmt.Errorf("API error: %w", apiErr)
+		}
+		return nil, fmt.Errorf("failed to search issues: %w", err)
+	}
+	if len(issues) == 0 {
+		return nil, fmt.Errorf("no issues match query: %s", jql)
+	}
+	if DryRun() {
+		return issues, nil
+	}
+	if err := checkBatchPolicy(len(issues)); err != nil {
+		return nil, err
+	}
+	if !confirmed && len(issues) > bulkConfirmThreshold {
+		return nil, fmt.Errorf("query matches %d issues: preview with --dry-run, then use --yes to change more than %d", len(issues), bulkConfirmThreshold)
+	}
+	return issues, nil
+}
+
+// issueValueKeys returns the keys of issues.
+func issueValueKeys(issues []issueValue) []string {
+	keys := make([]string, len(issues))
+	for i, issue := range issues {
+		keys[i] = issue.Key
+	}
+	return keys
+}
+
+// appendFieldChange appends a change unless the value is unchanged.
+func appendFieldChange(changes []FieldChange, field, from, to string) []FieldChange {
+	if from == to {
+		return changes
+	}
+	return append(changes, FieldChange{Field: field, From: from, To: to})
+}
+
+// fieldNames returns the names of a list field value: plain strings for
+// labels, or the name of each object for components and versions.
+func fieldNames(raw json.RawMessage) []string {
+	var names []string
+	if err := json.Unmarshal(raw, &names); err == nil {
+		return names
+	}
+
+	var objects []struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(raw, &objects); err != nil {
+		return nil
+	}
+	names = make([]string, len(objects))
+	for i, o := range objects {
+		names[i] = o.Name
+	}
+	return names
+}
+
+// applyListChange returns current with add appended and remove dropped,
+// comparing case-insensitively.
+func applyListChange(current, add, remove []string) []string {
+	result := make([]string, 0, len(current)+len(add))
+	for _, v := range current {
+		if !slices.ContainsFunc(remove, func(r string) bool { return strings.EqualFold(r, v) }) {
+			result = append(result, v)
+		}
+	}
+	for _, v := range add {
+		if !slices.ContainsFunc(result, func(r string) bool { return strings.EqualFold(r, v) }) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// printIssuePreviews prints the issues that would change and their field
+// diffs. total is the number of matched issues, including unchanged ones.
+func printIssuePreviews(previews []IssuePreview, total int) {
+	if JSONOutput() {
+		if previews == nil {
+			previews = []IssuePreview{}
+		}
+		output, err := json.MarshalIndent(previews, "", "  ")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to format JSON: %v\n", err)
+		} else {
+			fmt.Println(string(output))
+		}
+		return
+	}
+
+	for _, p := range previews {
+		fmt.Printf("%s: %s\n", p.Key, p.Summary)
+		for _, c := range p.Changes {
+			from := c.From
+			if from == "" {
+				from = "(none)"
+			}
+			to := c.To
+			if to == "" {
+				to = "(none)"
+			}
+			fmt.Printf("  %s: %s -> %s\n", c.Field, from, to)
+		}
+	}
+	fmt.Printf("\nWould change %d of %d issues\n", len(previews), total)
+}
+
+// previewText shortens multi-line text to its first line for previews.
+func previewText(s string) string {
+	s = strings.TrimSpace(s)
+	line, _, more := strings.Cut(s, "\n")
+	runes := []rune(line)
+	if len(runes) > 60 {
+		return string(runes[:60]) + "..."
+	}
+	if more {
+		return line + " ..."
+	}
+	return line
+}
@@ -0,0 +1,146 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/grantcarthew/ajira/internal/api"
+)
+
+func TestResolveJQLIssues(t *testing.T) {
+	defer func() { dryRun = false }()
+
+	tests := []struct {
+		name      string
+		count     int
+		confirmed bool
+		dryRun    bool
+		wantErr   string
+	}{
+		{name: "no matches", count: 0, wantErr: "no issues match query"},
+		{name: "at threshold", count: bulkConfirmThreshold},
+		{name: "above threshold", count: bulkConfirmThreshold + 1, wantErr: "use --yes"},
+		{name: "above threshold confirmed", count: bulkConfirmThreshold + 1, confirmed: true},
+		{name: "above threshold dry run", count: bulkConfirmThreshold + 1, dryRun: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/rest/api/3/search/jql" {
+					t.Errorf("unexpected path: %s", r.URL.Path)
+				}
+				resp := issueSearchResponse{IsLast: true}
+				for i := 1; i <= tt.count; i++ {
+					resp.Issues = append(resp.Issues, issueValue{Key: fmt.Sprintf("TEST-%d", i)})
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(resp)
+			}))
+			defer server.Close()
+			dryRun = tt.dryRun
+
+			client := api.NewClient(testConfig(server.URL))
+			issues, err := resolveJQLIssues(context.Background(), client, "project = TEST", "summary", tt.confirmed)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(issues) != tt.count {
+				t.Errorf("expected %d issues, got %d", tt.count, len(issues))
+			}
+		})
+	}
+}
+
+func TestApplyListChange(t *testing.T) {
+	got := applyListChange([]string{"legacy", "Backend"}, []string{"archived", "backend"}, []string{"LEGACY"})
+	if strings.Join(got, ",") != "Backend,archived" {
+		t.Errorf("unexpected list: %v", got)
+	}
+}
+
+func TestEditChanges(t *testing.T) {
+	defer func() {
+		editSummary, editAddLabels, editRemoveLabels, editComponents = "", nil, nil, nil
+	}()
+	editSummary = "Same"
+	editAddLabels = []string{"archived"}
+	editRemoveLabels = []string{"legacy"}
+	editComponents = []string{"API"}
+
+	var issue issueValue
+	data := `{"key": "TEST-1", "fields": {"summary": "Same", "labels": ["legacy", "keep"],
+		"components": [{"name": "API"}], "parent": {"key": "TEST-9"}}}`
+	if err := json.Unmarshal([]byte(data), &issue); err != nil {
+		t.Fatal(err)
+	}
+
+	fields := map[string]any{"summary": editSummary, "parent": nil}
+	changes := editChanges(issue, fields, nil, "")
+
+	want := []FieldChange{
+		{Field: "parent", From: "TEST-9", To: ""},
+		{Field: "labels", From: "legacy, keep", To: "keep, archived"},
+	}
+	if len(changes) != len(want) {
+		t.Fatalf("expected %d changes, got %+v", len(want), changes)
+	}
+	for i := range want {
+		if changes[i] != want[i] {
+			t.Errorf("change %d: expected %+v, got %+v", i, want[i], changes[i])
+		}
+	}
+}
+
+func TestAssignChanges(t *testing.T) {
+	id := "abc"
+	assigned := issueValue{Key: "TEST-1", Fields: issueFields{Assignee: &userField{AccountID: "abc", DisplayName: "Alice"}}}
+
+	if changes := assignChanges(assigned, &id, "me"); len(changes) != 0 {
+		t.Errorf("expected no change for same assignee, got %+v", changes)
+	}
+	changes := assignChanges(assigned, nil, "unassigned")
+	if len(changes) != 1 || changes[0].From != "Alice" || changes[0].To != "unassigned" {
+		t.Errorf("unexpected changes: %+v", changes)
+	}
+}
+
+func TestMoveChanges(t *testing.T) {
+	issue := issueValue{Key: "TEST-1", Fields: issueFields{
+		Status:   &statusField{Name: "Done"},
+		Assignee: &userField{AccountID: "abc", DisplayName: "Alice"},
+	}}
+	moveAssignee = "me"
+	defer func() { moveAssignee = "" }()
+
+	fields := map[string]any{"assignee": map[string]string{"accountId": "abc"}}
+	if changes := moveChanges(issue, "done", fields); len(changes) != 0 {
+		t.Errorf("expected no change for same status and assignee, got %+v", changes)
+	}
+
+	fields = map[string]any{"assignee": map[string]string{"accountId": "def"}}
+	changes := moveChanges(issue, "Done", fields)
+	if len(changes) != 1 || changes[0].Field != "assignee" || changes[0].From != "Alice" || changes[0].To != "me" {
+		t.Errorf("unexpected changes: %+v", changes)
+	}
+}
+
+func TestPreviewText(t *testing.T) {
+	if got := previewText("First line\nSecond"); got != "First line ..." {
+		t.Errorf("unexpected preview: %q", got)
+	}
+	if got := previewText(strings.Repeat("x", 70)); got != strings.Repeat("x", 60)+"..." {
+		t.Errorf("unexpected preview: %q", got)
+	}
+}
@@ -0,0 +1,216 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/grantcarthew/ajira/internal/api"
+)
+
+// jiraTimeLayouts are the timestamp formats returned by the Jira REST APIs.
+var jiraTimeLayouts = []string{
+	"2006-01-02T15:04:05.000-0700",
+	"2006-01-02T15:04:05.000Z07:00",
+	time.RFC3339,
+}
+
+// changelogResponse matches the paginated issue changelog API response.
+type changelogResponse struct {
+	StartAt    int                `json:"startAt"`
+	MaxResults int                `json:"maxResults"`
+	Total      int                `json:"total"`
+	IsLast     bool               `json:"isLast"`
+	Values     []changelogHistory `json:"values"`
+}
+
+type changelogHistory struct {
+	ID      string          `json:"id"`
+	Created string          `json:"created"`
+	Items   []changelogItem `json:"items"`
+}
+
+type changelogItem struct {
+	Field      string `json:"field"`
+	FieldID    string `json:"fieldId"`
+	From       string `json:"from"`
+	FromString string `json:"fromString"`
+	To         string `json:"to"`
+	ToString   string `json:"toString"`
+}
+
+// fieldChange is a single change to one field, in time order.
+type fieldChange struct {
+	At         time.Time
+	From       string
+	FromString string
+	To         string
+	ToString   string
+}
+
+// parseJiraTime parses a Jira timestamp in any of the known layouts.
+func parseJiraTime(s string) (time.Time, error) {
+	for _, layout := range jiraTimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time: %s", s)
+}
+
+// fetchChangelog returns the full change history of an issue, oldest first.
+func fetchChangelog(ctx context.Context, client *api.Client, key string) ([]changelogHistory, error) {
+	var histories []changelogHistory
+	startAt := 0
+	const maxPages = 100
+
+	for range maxPages {
+		path := fmt.Sprintf("/issue/%s/changelog?startAt=%d&maxResults=100", url.PathEscape(key), startAt)
+
+		body, err := client.Get(ctx, path)
+		if err != nil {
+			return nil, err
+		}
+
+		var resp changelogResponse
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return nil, fmt.Errorf("failed to parse response: %w", err)
+		}
+
+		histories = append(histories, resp.Values...)
+
+		startAt += len(resp.Values)
+		if resp.IsLast || len(resp.Values) == 0 || startAt >= resp.Total {
+			break
+		}
+	}
+
+	return histories, nil
+}
+
+// fetchChangelogs fetches changelogs for many issues with bounded concurrency.
+// If some fetches fail, it returns the changelogs it could read along with an
+// error naming the failed issues.
+func fetchChangelogs(ctx context.Context, client *api.Client, keys []string) (map[string][]changelogHistory, error) {
+	results := make(map[string][]changelogHistory, len(keys))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, treeConcurrency)
+	errs := make([]error, len(keys))
+
+	for i, key := range keys {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				errs[i] = ctx.Err()
+				return
+			}
+			defer func() { <-sem }()
+
+			histories, err := fetchChangelog(ctx, client, key)
+			if err != nil {
+				errs[i] = fmt.Errorf("%s: %w", key, err)
+				return
+			}
+			mu.Lock()
+			results[key] = histories
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	return results, errors.Join(errs...)
+}
+
+// fieldChanges extracts the changes to a field, matched by field ID or name,
+// sorted oldest first. Histories with unparseable timestamps are skipped.
+func fieldChanges(histories []changelogHistory, fieldID, fieldName string) []fieldChange {
+	var changes []fieldChange
+	for _, h := range histories {
+		at, err := parseJiraTime(h.Created)
+		if err != nil {
+			continue
+		}
+		for _, item := range h.Items {
+			if (fieldID != "" && item.FieldID == fieldID) || (fieldName != "" && strings.EqualFold(item.Field, fieldName)) {
+				changes = append(changes, fieldChange{
+					At:         at,
+					From:       item.From,
+					FromString: item.FromString,
+					To:         item.To,
+					ToString:   item.ToString,
+				})
+			}
+		}
+	}
+
+	slices.SortStableFunc(changes, func(a, b fieldChange) int {
+		return a.At.Compare(b.At)
+	})
+	return changes
+}
+
+// stateAt replays changes to find a field's value at time t. current is the
+// field's present value, used when the field never changed. value selects
+// which side of a change (ID or display string) to read.
+func stateAt(changes []fieldChange, current string, t time.Time, value func(fieldChange) (from, to string)) string {
+	if len(changes) == 0 {
+		return current
+	}
+
+	state, _ := value(changes[0])
+	for _, c := range changes {
+		if c.At.After(t) {
+			break
+		}
+		_, state = value(c)
+	}
+	return state
+}
+
+// changeIDs reads the raw ID side of a change.
+func changeIDs(c fieldChange) (string, string) {
+	return c.From, c.To
+}
+
+// changeStrings reads the display string side of a change.
+func changeStrings(c fieldChange) (string, string) {
+	return c.FromString, c.ToString
+}
+
+// statusCategoriesResponse matches an entry of the /status API response.
+type statusCategoriesResponse struct {
+	ID             string          `json:"id"`
+	StatusCategory *statusCategory `json:"statusCategory"`
+}
+
+// fetchStatusCategories maps every status ID on the instance to its status
+// category key (new, indeterminate, done).
+func fetchStatusCategories(ctx context.Context, client *api.Client) (map[string]string, error) {
+	body, err := client.Get(ctx, "/status")
+	if err != nil {
+		return nil, err
+	}
+
+	var resp []statusCategoriesResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	categories := make(map[string]string, len(resp))
+	for _, s := range resp {
+		if s.StatusCategory != nil {
+			categories[s.ID] = s.StatusCategory.Key
+		}
+	}
+	return categories, nil
+}
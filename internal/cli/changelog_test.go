@@ -0,0 +1,103 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/grantcarthew/ajira/internal/api"
+)
+
+func TestParseJiraTime(t *testing.T) {
+	tests := []string{
+		"2026-01-06T10:00:00.000+1100",
+		"2026-01-05T23:00:00.000Z",
+		"2026-01-05T23:00:00Z",
+	}
+	want := time.Date(2026, 1, 5, 23, 0, 0, 0, time.UTC)
+	for _, input := range tests {
+		got, err := parseJiraTime(input)
+		if err != nil {
+			t.Errorf("parseJiraTime(%q) error: %v", input, err)
+			continue
+		}
+		if !got.Equal(want) {
+			t.Errorf("parseJiraTime(%q) = %v, want %v", input, got, want)
+		}
+	}
+
+	if _, err := parseJiraTime("yesterday"); err == nil {
+		t.Error("expected error for invalid time")
+	}
+}
+
+func TestFieldChangesAndStateAt(t *testing.T) {
+	histories := []changelogHistory{
+		{Created: "2026-01-10T00:00:00.000+0000", Items: []changelogItem{
+			{Field: "status", FieldID: "status", From: "1", To: "3"},
+		}},
+		{Created: "2026-01-02T00:00:00.000+0000", Items: []changelogItem{
+			{Field: "summary", FieldID: "summary", ToString: "New"},
+			{Field: "status", FieldID: "status", From: "10", To: "1"},
+		}},
+	}
+
+	changes := fieldChanges(histories, "status", "")
+	if len(changes) != 2 {
+		t.Fatalf("expected 2 status changes, got %d", len(changes))
+	}
+	if changes[0].To != "1" {
+		t.Errorf("expected changes sorted oldest first, got %+v", changes)
+	}
+
+	tests := []struct {
+		at   string
+		want string
+	}{
+		{"2026-01-01T00:00:00Z", "10"},
+		{"2026-01-05T00:00:00Z", "1"},
+		{"2026-01-10T00:00:00Z", "3"},
+		{"2026-02-01T00:00:00Z", "3"},
+	}
+	for _, tt := range tests {
+		at, _ := time.Parse(time.RFC3339, tt.at)
+		if got := stateAt(changes, "3", at, changeIDs); got != tt.want {
+			t.Errorf("stateAt(%s) = %q, want %q", tt.at, got, tt.want)
+		}
+	}
+
+	if got := stateAt(nil, "current", time.Now(), changeIDs); got != "current" {
+		t.Errorf("expected current value without changes, got %q", got)
+	}
+}
+
+func TestFetchChangelog_Pagination(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/issue/GCP-1/changelog" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		resp := changelogResponse{Total: 2}
+		if r.URL.Query().Get("startAt") == "0" {
+			resp.Values = []changelogHistory{{ID: "1"}}
+		} else {
+			resp.Values = []changelogHistory{{ID: "2"}}
+			resp.IsLast = true
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	histories, err := fetchChangelogs(context.Background(), client, []string{"GCP-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(histories["GCP-1"]) != 2 {
+		t.Errorf("expected 2 histories, got %d", len(histories["GCP-1"]))
+	}
+}
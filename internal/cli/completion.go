@@ -1,15 +1,27 @@
 package cli
 
 import (
+	"context"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/grantcarthew/ajira/internal/api"
+	"github.com/grantcarthew/ajira/internal/cache"
+	"github.com/grantcarthew/ajira/internal/config"
+	"github.com/grantcarthew/ajira/internal/jira"
 	"github.com/spf13/cobra"
 )
 
 var completionCmd = &cobra.Command{
 	Use:   "completion [bash|zsh|fish|powershell]",
 	Short: "Generate shell autocompletion",
-	Long:  "Generate autocompletion script for your shell. Add to profile for persistent completion.",
+	Long: `Generate autocompletion script for your shell. Add to profile for persistent completion.
+
+Issue keys, transitions, statuses, priorities, issue types, components, versions, users, projects,
+boards, and sprints are completed from Jira. Suggestions are cached for a few minutes under the user
+cache directory; set AJIRA_CACHE to another directory, or to "off" to always fetch.`,
 	Example: `  # Bash (add to ~/.bashrc)
   source <(ajira completion bash)
 
@@ -38,3 +50,278 @@ var completionCmd = &cobra.Command{
 func init() {
 	rootCmd.AddCommand(completionCmd)
 }
+
+// Completion suggestions are cached so repeated tab presses do not wait on
+// Jira. Issue keys and transitions change with every edit, so they expire
+// sooner.
+const (
+	completionTTL      = 5 * time.Minute
+	completionIssueTTL = 30 * time.Second
+	completionTimeout  = 5 * time.Second
+	completionLimit    = 100
+)
+
+// completionIssueJQL selects the issues suggested as keys: open issues
+// assigned to the user and recently viewed issues.
+const completionIssueJQL = "(assignee = currentUser() AND statusCategory != Done) OR issue in issueHistory() ORDER BY updated DESC"
+
+// cachedCompletions returns the suggestions cached under key, or fetches and
+// caches them. Errors give no suggestions rather than interrupting the shell.
+func cachedCompletions(cmd *cobra.Command, key string, ttl time.Duration, fetch completionFetch) ([]cobra.Completion, cobra.ShellCompDirective) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	dir, _ := cache.Dir()
+
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(ctx, completionTimeout)
+	defer cancel()
+
+	// Entries are per site and account
+	key = cfg.BaseURL + " " + cfg.Email + " " + key
+	values, _ := loadCompletions(ctx, api.NewClient(cfg), dir, key, ttl, fetch)
+	return values, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completionFetch fetches suggestions from Jira.
+type completionFetch func(ctx context.Context, client *api.Client) ([]cobra.Completion, error)
+
+// loadCompletions returns the values cached under key in dir if younger than
+// ttl, or fetches and caches them. An empty dir disables the cache.
+func loadCompletions(ctx context.Context, client *api.Client, dir, key string, ttl time.Duration, fetch completionFetch) ([]cobra.Completion, error) {
+	if dir != "" {
+		if values, ok := cache.Load(dir, key, ttl); ok {
+			return values, nil
+		}
+	}
+
+	values, err := fetch(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	if dir != "" {
+		_ = cache.Store(dir, key, values)
+	}
+	return values, nil
+}
+
+// completionProject returns the project for project-scoped suggestions.
+// Completion runs before the project default is applied, so JIRA_PROJECT is
+// read here too.
+func completionProject() string {
+	if Project() != "" {
+		return strings.ToUpper(Project())
+	}
+	return strings.ToUpper(os.Getenv("JIRA_PROJECT"))
+}
+
+// completeFirstArg completes the first positional argument with complete,
+// and nothing after it.
+func completeFirstArg(complete cobra.CompletionFunc) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return complete(cmd, args, toComplete)
+	}
+}
+
+func completeIssueKeys(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	return cachedCompletions(cmd, "issues", completionIssueTTL, func(ctx context.Context, client *api.Client) ([]cobra.Completion, error) {
+		issues, err := searchIssues(ctx, client, completionIssueJQL, completionLimit)
+		if err != nil {
+			return nil, err
+		}
+		var values []cobra.Completion
+		for _, issue := range issues {
+			values = append(values, cobra.CompletionWithDesc(issue.Key, issue.Summary))
+		}
+		return values, nil
+	})
+}
+
+// completeTransitions suggests the target statuses available to an issue.
+func completeTransitions(cmd *cobra.Command, key string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	return cachedCompletions(cmd, "transitions "+strings.ToUpper(key), completionIssueTTL, func(ctx context.Context, client *api.Client) ([]cobra.Completion, error) {
+		transitions, err := getTransitions(ctx, client, key)
+		if err != nil {
+			return nil, err
+		}
+		var values []cobra.Completion
+		for _, t := range transitions {
+			values = append(values, cobra.CompletionWithDesc(t.To.Name, t.Name))
+		}
+		return values, nil
+	})
+}
+
+func completeStatuses(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	projectKey := completionProject()
+	if projectKey == "" {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return cachedCompletions(cmd, "statuses "+projectKey, completionTTL, func(ctx context.Context, client *api.Client) ([]cobra.Completion, error) {
+		statuses, err := jira.GetStatuses(ctx, client, projectKey)
+		if err != nil {
+			return nil, err
+		}
+		var values []cobra.Completion
+		for _, st := range statuses {
+			values = append(values, cobra.CompletionWithDesc(st.Name, st.Category))
+		}
+		return values, nil
+	})
+}
+
+func completePriorities(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	return cachedCompletions(cmd, "priorities", completionTTL, func(ctx context.Context, client *api.Client) ([]cobra.Completion, error) {
+		priorities, err := jira.GetPriorities(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+		var values []cobra.Completion
+		for _, p := range priorities {
+			values = append(values, p.Name)
+		}
+		return values, nil
+	})
+}
+
+func completeIssueTypes(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	projectKey := completionProject()
+	if projectKey == "" {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return cachedCompletions(cmd, "types "+projectKey, completionTTL, func(ctx context.Context, client *api.Client) ([]cobra.Completion, error) {
+		types, err := jira.GetIssueTypes(ctx, client, projectKey)
+		if err != nil {
+			return nil, err
+		}
+		var values []cobra.Completion
+		for _, t := range types {
+			values = append(values, cobra.CompletionWithDesc(t.Name, t.Description))
+		}
+		return values, nil
+	})
+}
+
+func completeComponents(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	projectKey := completionProject()
+	if projectKey == "" {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return cachedCompletions(cmd, "components "+projectKey, completionTTL, func(ctx context.Context, client *api.Client) ([]cobra.Completion, error) {
+		components, err := fetchComponents(ctx, client, projectKey, "", completionLimit)
+		if err != nil {
+			return nil, err
+		}
+		var values []cobra.Completion
+		for _, c := range components {
+			values = append(values, cobra.CompletionWithDesc(c.Name, c.Description))
+		}
+		return values, nil
+	})
+}
+
+func completeVersions(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	projectKey := completionProject()
+	if projectKey == "" {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return cachedCompletions(cmd, "versions "+projectKey, completionTTL, func(ctx context.Context, client *api.Client) ([]cobra.Completion, error) {
+		releases, err := fetchAllReleases(ctx, client, projectKey, "", completionLimit)
+		if err != nil {
+			return nil, err
+		}
+		var values []cobra.Completion
+		for _, r := range releases {
+			values = append(values, cobra.CompletionWithDesc(r.Name, r.Description))
+		}
+		return values, nil
+	})
+}
+
+// completeUsers suggests me and unassigned, and users matching the text
+// typed so far by email (or account ID when the email is hidden).
+func completeUsers(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	values := []cobra.Completion{"me", "unassigned"}
+	if toComplete == "" {
+		return values, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	users, directive := cachedCompletions(cmd, "users "+strings.ToLower(toComplete), completionTTL, func(ctx context.Context, client *api.Client) ([]cobra.Completion, error) {
+		users, err := searchUsers(ctx, client, toComplete, 20)
+		if err != nil {
+			return nil, err
+		}
+		var values []cobra.Completion
+		for _, u := range users {
+			if !u.Active {
+				continue
+			}
+			value := u.EmailAddress
+			if value == "" {
+				value = u.AccountID
+			}
+			values = append(values, cobra.CompletionWithDesc(value, u.DisplayName))
+		}
+		return values, nil
+	})
+	return append(values, users...), directive
+}
+
+func completeProjects(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	return cachedCompletions(cmd, "projects", completionTTL, func(ctx context.Context, client *api.Client) ([]cobra.Completion, error) {
+		projects, err := fetchAllProjects(ctx, client, "", completionLimit)
+		if err != nil {
+			return nil, err
+		}
+		var values []cobra.Completion
+		for _, p := range projects {
+			values = append(values, cobra.CompletionWithDesc(p.Key, p.Name))
+		}
+		return values, nil
+	})
+}
+
+func completeBoards(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	projectKey := completionProject()
+	return cachedCompletions(cmd, "boards "+projectKey, completionTTL, func(ctx context.Context, client *api.Client) ([]cobra.Completion, error) {
+		boards, err := listBoards(ctx, client, projectKey, completionLimit)
+		if err != nil {
+			return nil, err
+		}
+		var values []cobra.Completion
+		for _, b := range boards {
+			values = append(values, cobra.CompletionWithDesc(strconv.Itoa(b.ID), b.Name))
+		}
+		return values, nil
+	})
+}
+
+// completeSprints suggests the active and future sprints of the board set
+// with --board or JIRA_BOARD.
+func completeSprints(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	boardID := Board()
+	if boardID == "" {
+		boardID = os.Getenv("JIRA_BOARD")
+	}
+	if boardID == "" {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return cachedCompletions(cmd, "sprints "+boardID, completionTTL, func(ctx context.Context, client *api.Client) ([]cobra.Completion, error) {
+		sprints, err := listSprints(ctx, client, boardID, "active,future", completionLimit)
+		if err != nil {
+			return nil, err
+		}
+		var values []cobra.Completion
+		for _, sp := range sprints {
+			values = append(values, cobra.CompletionWithDesc(strconv.Itoa(sp.ID), sp.Name))
+		}
+		return values, nil
+	})
+}
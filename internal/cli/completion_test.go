@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grantcarthew/ajira/internal/api"
+	"github.com/spf13/cobra"
+)
+
+func TestLoadCompletions(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"issues": [{"key": "TEST-1", "fields": {"summary": "First"}}, {"key": "TEST-2", "fields": {"summary": "Second"}}]}`))
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	fetch := func(ctx context.Context, client *api.Client) ([]cobra.Completion, error) {
+		issues, err := searchIssues(ctx, client, completionIssueJQL, completionLimit)
+		if err != nil {
+			return nil, err
+		}
+		var values []cobra.Completion
+		for _, issue := range issues {
+			values = append(values, cobra.CompletionWithDesc(issue.Key, issue.Summary))
+		}
+		return values, nil
+	}
+
+	dir := t.TempDir()
+	for range 2 {
+		values, err := loadCompletions(context.Background(), client, dir, "issues", time.Minute, fetch)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if strings.Join(values, ",") != "TEST-1\tFirst,TEST-2\tSecond" {
+			t.Errorf("unexpected values: %q", values)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("expected one request with a warm cache, got %d", calls)
+	}
+
+	if _, err := loadCompletions(context.Background(), client, "", "issues", time.Minute, fetch); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("expected a request with the cache off, got %d", calls)
+	}
+}
+
+func TestCompleteFirstArg(t *testing.T) {
+	complete := completeFirstArg(func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		return []cobra.Completion{"TEST-1"}, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	if values, _ := complete(nil, nil, ""); len(values) != 1 {
+		t.Errorf("expected first argument to be completed, got %v", values)
+	}
+	if values, _ := complete(nil, []string{"TEST-1"}, ""); len(values) != 0 {
+		t.Errorf("expected no completions after the first argument, got %v", values)
+	}
+}
+
+func TestCompleteUsers_Empty(t *testing.T) {
+	values, directive := completeUsers(nil, nil, "")
+	if strings.Join(values, ",") != "me,unassigned" {
+		t.Errorf("unexpected values: %v", values)
+	}
+	if directive != cobra.ShellCompDirectiveNoFileComp {
+		t.Errorf("unexpected directive: %v", directive)
+	}
+}
+
+func TestCompleteStatuses_NoProject(t *testing.T) {
+	t.Setenv("JIRA_PROJECT", "")
+	project = ""
+
+	if values, _ := completeStatuses(nil, nil, ""); len(values) != 0 {
+		t.Errorf("expected no completions without a project, got %v", values)
+	}
+}
@@ -0,0 +1,188 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/grantcarthew/ajira/internal/api"
+	"github.com/spf13/cobra"
+)
+
+// ComponentInfo represents a project component.
+type ComponentInfo struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	Description   string `json:"description,omitempty"`
+	Lead          string `json:"lead,omitempty"`
+	LeadAccountID string `json:"leadAccountId,omitempty"`
+	AssigneeType  string `json:"assigneeType"`
+	Assignee      string `json:"assignee,omitempty"`
+	Project       string `json:"project"`
+	IssueCount    *int   `json:"issueCount,omitempty"`
+}
+
+// componentListResponse matches the paginated project components API response.
+type componentListResponse struct {
+	Values     []componentValue `json:"values"`
+	StartAt    int              `json:"startAt"`
+	MaxResults int              `json:"maxResults"`
+	Total      int              `json:"total"`
+	IsLast     bool             `json:"isLast"`
+}
+
+type componentValue struct {
+	ID           string     `json:"id"`
+	Name         string     `json:"name"`
+	Description  string     `json:"description"`
+	Lead         *userField `json:"lead"`
+	AssigneeType string     `json:"assigneeType"`
+	Assignee     *userField `json:"assignee"`
+	Project      string     `json:"project"`
+	IssueCount   *int       `json:"issueCount"`
+}
+
+// componentAssigneeTypes maps --default-assignee values to Jira assignee types.
+var componentAssigneeTypes = map[string]string{
+	"project-default": "PROJECT_DEFAULT",
+	"component-lead":  "COMPONENT_LEAD",
+	"project-lead":    "PROJECT_LEAD",
+	"unassigned":      "UNASSIGNED",
+}
+
+var componentCmd = &cobra.Command{
+	Use:     "component",
+	Aliases: []string{"components"},
+	Short:   "Manage components",
+	Long:    "Commands for managing project components. Components are referenced by name or ID.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(componentCmd)
+}
+
+func componentInfoFromValue(v componentValue) ComponentInfo {
+	info := ComponentInfo{
+		ID:           v.ID,
+		Name:         v.Name,
+		Description:  v.Description,
+		AssigneeType: v.AssigneeType,
+		Project:      v.Project,
+		IssueCount:   v.IssueCount,
+	}
+	if v.Lead != nil {
+		info.Lead = v.Lead.DisplayName
+		info.LeadAccountID = v.Lead.AccountID
+	}
+	if v.Assignee != nil {
+		info.Assignee = v.Assignee.DisplayName
+	}
+	return info
+}
+
+// fetchComponents lists a project's components with their issue counts.
+func fetchComponents(ctx context.Context, client *api.Client, projectKey, query string, limit int) ([]ComponentInfo, error) {
+	var all []ComponentInfo
+	startAt := 0
+	maxResults := 50
+
+	for {
+		path := fmt.Sprintf("/project/%s/component?startAt=%d&maxResults=%d&orderBy=name", url.PathEscape(projectKey), startAt, maxResults)
+		if query != "" {
+			path += "&query=" + url.QueryEscape(query)
+		}
+
+		body, err := client.Get(ctx, path)
+		if err != nil {
+			return nil, err
+		}
+
+		var resp componentListResponse
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return nil, fmt.Errorf("failed to parse response: %w", err)
+		}
+
+		for _, v := range resp.Values {
+			all = append(all, componentInfoFromValue(v))
+
+			if limit > 0 && len(all) >= limit {
+				return all[:limit], nil
+			}
+		}
+
+		if resp.IsLast || len(resp.Values) == 0 {
+			break
+		}
+
+		startAt += maxResults
+	}
+
+	return all, nil
+}
+
+// getComponent fetches a component by ID, including its issue count.
+func getComponent(ctx context.Context, client *api.Client, id string) (*ComponentInfo, error) {
+	body, err := client.Get(ctx, "/component/"+url.PathEscape(id))
+	if err != nil {
+		return nil, err
+	}
+
+	var v componentValue
+	if err := json.Unmarshal(body, &v); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	body, err = client.Get(ctx, fmt.Sprintf("/component/%s/relatedIssueCounts", url.PathEscape(id)))
+	if err != nil {
+		return nil, err
+	}
+
+	var counts struct {
+		IssueCount int `json:"issueCount"`
+	}
+	if err := json.Unmarshal(body, &counts); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	v.IssueCount = &counts.IssueCount
+
+	info := componentInfoFromValue(v)
+	return &info, nil
+}
+
+// resolveComponentID returns the ID of a component given its numeric ID or
+// its name within the project. Name matching is case-insensitive.
+func resolveComponentID(ctx context.Context, client *api.Client, projectKey, nameOrID string) (string, error) {
+	if _, err := strconv.Atoi(nameOrID); err == nil {
+		return nameOrID, nil
+	}
+	if projectKey == "" {
+		return "", fmt.Errorf("project is required to find component by name: use -p flag or set JIRA_PROJECT environment variable")
+	}
+
+	components, err := fetchComponents(ctx, client, projectKey, "", 0)
+	if err != nil {
+		return "", err
+	}
+	for _, c := range components {
+		if strings.EqualFold(c.Name, nameOrID) {
+			return c.ID, nil
+		}
+	}
+	return "", fmt.Errorf("component not found: %s", nameOrID)
+}
+
+// componentAssigneeType converts a --default-assignee value to a Jira
+// assignee type.
+func componentAssigneeType(value string) (string, error) {
+	t, ok := componentAssigneeTypes[strings.ToLower(value)]
+	if !ok {
+		return "", fmt.Errorf("invalid default assignee: %s (valid: project-default, component-lead, project-lead, unassigned)", value)
+	}
+	return t, nil
+}
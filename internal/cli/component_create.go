@@ -0,0 +1,129 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/grantcarthew/ajira/internal/api"
+	"github.com/grantcarthew/ajira/internal/config"
+	"github.com/spf13/cobra"
+)
+
+var (
+	componentCreateDescription string
+	componentCreateLead        string
+	componentCreateAssignee    string
+)
+
+var componentCreateCmd = &cobra.Command{
+	Use:   "create <name>",
+	Short: "Create component",
+	Long:  "Create a component in the project. Requires -p or JIRA_PROJECT.",
+	Example: `  ajira component create Backend
+  ajira component create API -d "Public REST API" --lead me
+  ajira component create Web --lead user@example.com --default-assignee component-lead`,
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE:         runComponentCreate,
+}
+
+func init() {
+	componentCreateCmd.Flags().StringVarP(&componentCreateDescription, "description", "d", "", "Component description")
+	componentCreateCmd.Flags().StringVar(&componentCreateLead, "lead", "", "Component lead (me, email, or account ID)")
+	componentCreateCmd.Flags().StringVar(&componentCreateAssignee, "default-assignee", "", "Default assignee: project-default, component-lead, project-lead, unassigned")
+
+	_ = componentCreateCmd.RegisterFlagCompletionFunc("lead", completeUsers)
+
+	componentCmd.AddCommand(componentCreateCmd)
+}
+
+func runComponentCreate(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	name := args[0]
+
+	projectKey := Project()
+	if projectKey == "" {
+		return fmt.Errorf("project is required: use -p flag or set JIRA_PROJECT environment variable")
+	}
+
+	req := map[string]any{
+		"name":    name,
+		"project": projectKey,
+	}
+	if componentCreateDescription != "" {
+		req["description"] = componentCreateDescription
+	}
+	if componentCreateAssignee != "" {
+		assigneeType, err := componentAssigneeType(componentCreateAssignee)
+		if err != nil {
+			return err
+		}
+		req["assigneeType"] = assigneeType
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(cfg)
+
+	if componentCreateLead != "" {
+		leadID, err := resolveAssigneeInput(ctx, client, cfg.Email, componentCreateLead)
+		if err != nil {
+			return fmt.Errorf("failed to resolve lead: %w", err)
+		}
+		if leadID != nil {
+			req["leadAccountId"] = *leadID
+		}
+	}
+
+	if DryRun() {
+		PrintDryRun(fmt.Sprintf("create component %s in %s", name, projectKey))
+		return nil
+	}
+
+	component, err := saveComponent(ctx, client, "", req)
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); ok {
+			return fmt.Errorf("API error: %w", apiErr)
+		}
+		return fmt.Errorf("failed to create component: %w", err)
+	}
+
+	if JSONOutput() {
+		PrintSuccessJSON(component)
+	} else {
+		PrintSuccess(fmt.Sprintf("Component %s created (id %s)", component.Name, component.ID))
+	}
+
+	return nil
+}
+
+// saveComponent creates a component when id is empty, otherwise updates it.
+func saveComponent(ctx context.Context, client *api.Client, id string, req map[string]any) (*ComponentInfo, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	var respBody []byte
+	if id == "" {
+		respBody, err = client.Post(ctx, "/component", body)
+	} else {
+		respBody, err = client.Put(ctx, "/component/"+url.PathEscape(id), body)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var v componentValue
+	if err := json.Unmarshal(respBody, &v); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	info := componentInfoFromValue(v)
+	return &info, nil
+}
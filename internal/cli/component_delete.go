@@ -0,0 +1,104 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/grantcarthew/ajira/internal/api"
+	"github.com/grantcarthew/ajira/internal/config"
+	"github.com/spf13/cobra"
+)
+
+var componentDeleteMoveTo string
+
+var componentDeleteCmd = &cobra.Command{
+	Use:   "delete <component>",
+	Short: "Delete component",
+	Long: `Delete a component. Its issues lose the component unless --move-issues-to names a replacement.
+Names are resolved within -p or JIRA_PROJECT.`,
+	Example: `  ajira component delete Legacy
+  ajira component delete Legacy --move-issues-to Backend
+  ajira component delete 10042 --dry-run`,
+	Args:              cobra.ExactArgs(1),
+	SilenceUsage:      true,
+	ValidArgsFunction: completeFirstArg(completeComponents),
+	RunE:              runComponentDelete,
+}
+
+func init() {
+	componentDeleteCmd.Flags().StringVar(&componentDeleteMoveTo, "move-issues-to", "", "Component (name or ID) to move the deleted component's issues to")
+
+	_ = componentDeleteCmd.RegisterFlagCompletionFunc("move-issues-to", completeComponents)
+
+	componentCmd.AddCommand(componentDeleteCmd)
+}
+
+func runComponentDelete(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	name := args[0]
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(cfg)
+
+	id, err := resolveComponentID(ctx, client, Project(), name)
+	if err != nil {
+		return err
+	}
+
+	moveToID := ""
+	if componentDeleteMoveTo != "" {
+		moveToID, err = resolveComponentID(ctx, client, Project(), componentDeleteMoveTo)
+		if err != nil {
+			return err
+		}
+		if moveToID == id {
+			return fmt.Errorf("cannot move issues to the component being deleted")
+		}
+	}
+
+	if DryRun() {
+		action := fmt.Sprintf("delete component %s", name)
+		if moveToID != "" {
+			action += fmt.Sprintf(" and move its issues to %s", componentDeleteMoveTo)
+		}
+		PrintDryRun(action)
+		return nil
+	}
+
+	if err := deleteComponent(ctx, client, id, moveToID); err != nil {
+		if apiErr, ok := err.(*api.APIError); ok {
+			return fmt.Errorf("API error: %w", apiErr)
+		}
+		return fmt.Errorf("failed to delete component: %w", err)
+	}
+
+	if JSONOutput() {
+		result := map[string]string{"id": id, "status": "deleted"}
+		if moveToID != "" {
+			result["movedIssuesTo"] = moveToID
+		}
+		PrintSuccessJSON(result)
+	} else {
+		msg := fmt.Sprintf("Component %s deleted", name)
+		if moveToID != "" {
+			msg += fmt.Sprintf("; issues moved to %s", componentDeleteMoveTo)
+		}
+		PrintSuccess(msg)
+	}
+
+	return nil
+}
+
+func deleteComponent(ctx context.Context, client *api.Client, id, moveToID string) error {
+	path := "/component/" + url.PathEscape(id)
+	if moveToID != "" {
+		path += "?moveIssuesTo=" + url.QueryEscape(moveToID)
+	}
+	_, err := client.Delete(ctx, path)
+	return err
+}
@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/grantcarthew/ajira/internal/api"
+	"github.com/grantcarthew/ajira/internal/config"
+	"github.com/spf13/cobra"
+)
+
+var (
+	componentEditName        string
+	componentEditDescription string
+	componentEditLead        string
+	componentEditAssignee    string
+)
+
+var componentEditCmd = &cobra.Command{
+	Use:   "edit <component>",
+	Short: "Edit component",
+	Long:  "Update a component's name, description, lead, or default assignee. Names are resolved within -p or JIRA_PROJECT.",
+	Example: `  ajira component edit Backend --name "Backend Services"
+  ajira component edit Backend --lead user@example.com --default-assignee component-lead
+  ajira component edit 10042 --lead unassigned          # Remove the lead
+  ajira component edit Backend -d ""                    # Clear the description`,
+	Args:              cobra.ExactArgs(1),
+	SilenceUsage:      true,
+	ValidArgsFunction: completeFirstArg(completeComponents),
+	RunE:              runComponentEdit,
+}
+
+func init() {
+	componentEditCmd.Flags().StringVar(&componentEditName, "name", "", "New component name")
+	componentEditCmd.Flags().StringVarP(&componentEditDescription, "description", "d", "", "Component description")
+	componentEditCmd.Flags().StringVar(&componentEditLead, "lead", "", "Component lead (me, email, account ID, or unassigned)")
+	componentEditCmd.Flags().StringVar(&componentEditAssignee, "default-assignee", "", "Default assignee: project-default, component-lead, project-lead, unassigned")
+
+	_ = componentEditCmd.RegisterFlagCompletionFunc("lead", completeUsers)
+
+	componentCmd.AddCommand(componentEditCmd)
+}
+
+func runComponentEdit(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	flags := cmd.Flags()
+
+	if !flags.Changed("name") && !flags.Changed("description") && !flags.Changed("lead") && !flags.Changed("default-assignee") {
+		return fmt.Errorf("no changes specified; use --name, --description, --lead, or --default-assignee")
+	}
+
+	req := map[string]any{}
+	if flags.Changed("name") {
+		if componentEditName == "" {
+			return fmt.Errorf("--name cannot be empty")
+		}
+		req["name"] = componentEditName
+	}
+	if flags.Changed("description") {
+		req["description"] = componentEditDescription
+	}
+	if flags.Changed("default-assignee") {
+		assigneeType, err := componentAssigneeType(componentEditAssignee)
+		if err != nil {
+			return err
+		}
+		req["assigneeType"] = assigneeType
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(cfg)
+
+	if flags.Changed("lead") {
+		leadID, err := resolveAssigneeInput(ctx, client, cfg.Email, componentEditLead)
+		if err != nil {
+			return fmt.Errorf("failed to resolve lead: %w", err)
+		}
+		if leadID != nil {
+			req["leadAccountId"] = *leadID
+		} else {
+			req["leadAccountId"] = nil
+		}
+	}
+
+	id, err := resolveComponentID(ctx, client, Project(), args[0])
+	if err != nil {
+		return err
+	}
+
+	if DryRun() {
+		PrintDryRun(fmt.Sprintf("edit component %s", args[0]))
+		return nil
+	}
+
+	component, err := saveComponent(ctx, client, id, req)
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); ok {
+			return fmt.Errorf("API error: %w", apiErr)
+		}
+		return fmt.Errorf("failed to edit component: %w", err)
+	}
+
+	if JSONOutput() {
+		PrintSuccessJSON(component)
+	} else {
+		PrintSuccess(fmt.Sprintf("Component %s updated", component.Name))
+	}
+
+	return nil
+}
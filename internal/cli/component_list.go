@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/grantcarthew/ajira/internal/api"
+	"github.com/grantcarthew/ajira/internal/config"
+	"github.com/spf13/cobra"
+)
+
+var (
+	componentListQuery string
+	componentListLimit int
+)
+
+var componentListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List components",
+	Long:    "List project components with lead, default assignee, and issue counts. Requires -p or JIRA_PROJECT.",
+	Example: `  ajira component list                # List components in default project
+  ajira component list -p GCP         # List components in specific project
+  ajira component list -q api         # Filter by name
+  ajira component list --json`,
+	SilenceUsage: true,
+	RunE:         runComponentList,
+}
+
+func init() {
+	componentListCmd.Flags().StringVarP(&componentListQuery, "query", "q", "", "Filter by component name")
+	componentListCmd.Flags().IntVarP(&componentListLimit, "limit", "l", 0, "Maximum components to return (0 = all)")
+
+	componentCmd.AddCommand(componentListCmd)
+}
+
+func runComponentList(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	projectKey := Project()
+	if projectKey == "" {
+		return fmt.Errorf("project is required: use -p flag or set JIRA_PROJECT environment variable")
+	}
+
+	client := api.NewClient(cfg)
+
+	components, err := fetchComponents(ctx, client, projectKey, componentListQuery, componentListLimit)
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); ok {
+			return fmt.Errorf("API error: %w", apiErr)
+		}
+		return fmt.Errorf("failed to fetch components: %w", err)
+	}
+
+	if JSONOutput() {
+		if components == nil {
+			components = []ComponentInfo{}
+		}
+		output, err := json.MarshalIndent(components, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to format JSON: %w", err)
+		}
+		fmt.Println(string(output))
+	} else {
+		if len(components) == 0 {
+			fmt.Println("No components found.")
+			return nil
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tNAME\tLEAD\tDEFAULT ASSIGNEE\tISSUES")
+		for _, c := range components {
+			lead := c.Lead
+			if lead == "" {
+				lead = "-"
+			}
+			issues := "-"
+			if c.IssueCount != nil {
+				issues = fmt.Sprintf("%d", *c.IssueCount)
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", c.ID, c.Name, lead, c.AssigneeType, issues)
+		}
+		w.Flush()
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/grantcarthew/ajira/internal/api"
+)
+
+func TestFetchComponents_WithIssueCounts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/project/GCP/component" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("query") != "back end" {
+			t.Errorf("expected query filter, got %q", r.URL.Query().Get("query"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"isLast":true,"values":[
+			{"id":"10000","name":"Backend","lead":{"displayName":"Jane","accountId":"abc"},"assigneeType":"COMPONENT_LEAD","project":"GCP","issueCount":12},
+			{"id":"10001","name":"Backend API","assigneeType":"PROJECT_DEFAULT","project":"GCP","issueCount":0}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	components, err := fetchComponents(context.Background(), client, "GCP", "back end", 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(components) != 2 {
+		t.Fatalf("expected 2 components, got %d", len(components))
+	}
+	c := components[0]
+	if c.Lead != "Jane" || c.LeadAccountID != "abc" || c.AssigneeType != "COMPONENT_LEAD" || c.IssueCount == nil || *c.IssueCount != 12 {
+		t.Errorf("unexpected component: %+v", c)
+	}
+	if components[1].IssueCount == nil || *components[1].IssueCount != 0 {
+		t.Errorf("expected zero issue count preserved, got %v", components[1].IssueCount)
+	}
+}
+
+func TestGetComponent_IncludesIssueCount(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/api/3/component/10000":
+			_, _ = w.Write([]byte(`{"id":"10000","name":"Backend","description":"Services","assigneeType":"PROJECT_LEAD","project":"GCP"}`))
+		case "/rest/api/3/component/10000/relatedIssueCounts":
+			_, _ = w.Write([]byte(`{"issueCount":7}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	c, err := getComponent(context.Background(), client, "10000")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c.Name != "Backend" || c.Description != "Services" || c.IssueCount == nil || *c.IssueCount != 7 {
+		t.Errorf("unexpected component: %+v", c)
+	}
+}
+
+func TestResolveComponentID(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"isLast":true,"values":[{"id":"10000","name":"Backend"},{"id":"10001","name":"Web"}]}`))
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	ctx := context.Background()
+
+	id, err := resolveComponentID(ctx, client, "GCP", "10042")
+	if err != nil || id != "10042" || requests != 0 {
+		t.Errorf("expected numeric ID used directly, got %q, %v (%d requests)", id, err, requests)
+	}
+
+	id, err = resolveComponentID(ctx, client, "GCP", "web")
+	if err != nil || id != "10001" {
+		t.Errorf("expected case-insensitive name match, got %q, %v", id, err)
+	}
+
+	if _, err := resolveComponentID(ctx, client, "GCP", "Mobile"); err == nil || !strings.Contains(err.Error(), "component not found") {
+		t.Errorf("expected not found error, got %v", err)
+	}
+
+	if _, err := resolveComponentID(ctx, client, "", "Web"); err == nil {
+		t.Error("expected error when project is missing")
+	}
+}
+
+func TestSaveComponent_CreateAndUpdate(t *testing.T) {
+	var methods, paths []string
+	var bodies []map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		paths = append(paths, r.URL.Path)
+		data, _ := io.ReadAll(r.Body)
+		var body map[string]any
+		_ = json.Unmarshal(data, &body)
+		bodies = append(bodies, body)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"10005","name":"API","assigneeType":"COMPONENT_LEAD","project":"GCP"}`))
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	ctx := context.Background()
+
+	c, err := saveComponent(ctx, client, "", map[string]any{"name": "API", "project": "GCP", "assigneeType": "COMPONENT_LEAD"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c.ID != "10005" {
+		t.Errorf("expected created component ID, got %+v", c)
+	}
+
+	if _, err := saveComponent(ctx, client, "10005", map[string]any{"leadAccountId": nil}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if methods[0] != http.MethodPost || paths[0] != "/rest/api/3/component" {
+		t.Errorf("expected POST /component, got %s %s", methods[0], paths[0])
+	}
+	if methods[1] != http.MethodPut || paths[1] != "/rest/api/3/component/10005" {
+		t.Errorf("expected PUT /component/10005, got %s %s", methods[1], paths[1])
+	}
+	if v, ok := bodies[1]["leadAccountId"]; !ok || v != nil {
+		t.Errorf("expected explicit null lead, got %v", bodies[1])
+	}
+}
+
+func TestDeleteComponent_MoveIssues(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/rest/api/3/component/10000" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if r.URL.Query().Get("moveIssuesTo") != "10001" {
+			t.Errorf("expected moveIssuesTo=10001, got %q", r.URL.RawQuery)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	if err := deleteComponent(context.Background(), client, "10000", "10001"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestComponentAssigneeType(t *testing.T) {
+	if got, err := componentAssigneeType("Component-Lead"); err != nil || got != "COMPONENT_LEAD" {
+		t.Errorf("expected COMPONENT_LEAD, got %q, %v", got, err)
+	}
+	if _, err := componentAssigneeType("team"); err == nil {
+		t.Error("expected error for invalid assignee type")
+	}
+}
@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/grantcarthew/ajira/internal/api"
+	"github.com/grantcarthew/ajira/internal/config"
+	"github.com/spf13/cobra"
+)
+
+var componentViewCmd = &cobra.Command{
+	Use:   "view <component>",
+	Short: "View component",
+	Long:  "Display a component's details and issue count. Names are resolved within -p or JIRA_PROJECT.",
+	Example: `  ajira component view Backend
+  ajira component view 10042 --json`,
+	Args:              cobra.ExactArgs(1),
+	SilenceUsage:      true,
+	ValidArgsFunction: completeFirstArg(completeComponents),
+	RunE:              runComponentView,
+}
+
+func init() {
+	componentCmd.AddCommand(componentViewCmd)
+}
+
+func runComponentView(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(cfg)
+
+	id, err := resolveComponentID(ctx, client, Project(), args[0])
+	if err != nil {
+		return err
+	}
+
+	component, err := getComponent(ctx, client, id)
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); ok {
+			return fmt.Errorf("API error: %w", apiErr)
+		}
+		return fmt.Errorf("failed to fetch component: %w", err)
+	}
+
+	if JSONOutput() {
+		output, err := json.MarshalIndent(component, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to format JSON: %w", err)
+		}
+		fmt.Println(string(output))
+	} else {
+		printComponent(component)
+	}
+
+	return nil
+}
+
+func printComponent(c *ComponentInfo) {
+	bold := color.New(color.Bold).SprintFunc()
+
+	orDash := func(s string) string {
+		if s == "" {
+			return "-"
+		}
+		return s
+	}
+
+	fmt.Printf("%s  %s\n", bold(c.Name), c.ID)
+	fmt.Printf("Project:           %s\n", c.Project)
+	fmt.Printf("Lead:              %s\n", orDash(c.Lead))
+	fmt.Printf("Default assignee:  %s\n", c.AssigneeType)
+	if c.Assignee != "" {
+		fmt.Printf("Assignee:          %s\n", c.Assignee)
+	}
+	if c.IssueCount != nil {
+		fmt.Printf("Issues:            %d\n", *c.IssueCount)
+	}
+	if c.Description != "" {
+		fmt.Println()
+		fmt.Println(c.Description)
+	}
+}
@@ -36,8 +36,9 @@ var epicAddCmd = &cobra.Command{
 		}
 		return nil
 	},
-	SilenceUsage: true,
-	RunE:         runEpicAdd,
+	SilenceUsage:      true,
+	ValidArgsFunction: completeIssueKeys,
+	RunE:              runEpicAdd,
 }
 
 func init() {
@@ -58,6 +59,9 @@ func runEpicAdd(cmd *cobra.Command, args []string) error {
 		if err != nil {
 			return err
 		}
+		if err := checkBatchPolicy(len(issueKeys)); err != nil {
+			return err
+		}
 		if len(issueKeys) == 0 {
 			return fmt.Errorf("no issue keys provided via stdin")
 		}
@@ -43,6 +43,9 @@ func init() {
 
 	_ = epicCreateCmd.MarkFlagRequired("summary")
 
+	_ = epicCreateCmd.RegisterFlagCompletionFunc("priority", completePriorities)
+	_ = epicCreateCmd.RegisterFlagCompletionFunc("assignee", completeUsers)
+
 	epicCmd.AddCommand(epicCreateCmd)
 }
 
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -17,8 +18,15 @@ var (
 	epicListAssignee string
 	epicListPriority string
 	epicListLimit    int
+	epicListProgress bool
 )
 
+// EpicSummary is an epic with its child progress, as listed by --progress.
+type EpicSummary struct {
+	IssueInfo
+	Progress EpicProgress `json:"progress"`
+}
+
 var epicListCmd = &cobra.Command{
 	Use:     "list",
 	Aliases: []string{"ls"},
@@ -27,7 +35,8 @@ var epicListCmd = &cobra.Command{
 	Example: `  ajira epic list                        # List epics in default project
   ajira epic list -p GCP                 # List epics in specific project
   ajira epic list --status "In Progress" # Filter by status
-  ajira epic list -l 10                  # Limit results`,
+  ajira epic list -l 10                  # Limit results
+  ajira epic list --progress             # Include child progress and points`,
 	SilenceUsage: true,
 	RunE:         runEpicList,
 }
@@ -37,6 +46,11 @@ func init() {
 	epicListCmd.Flags().StringVarP(&epicListAssignee, "assignee", "a", "", "Filter by assignee (email, accountId, 'me', or 'unassigned')")
 	epicListCmd.Flags().StringVarP(&epicListPriority, "priority", "P", "", "Filter by priority")
 	epicListCmd.Flags().IntVarP(&epicListLimit, "limit", "l", 50, "Maximum epics to return")
+	epicListCmd.Flags().BoolVar(&epicListProgress, "progress", false, "Include child issue progress and story points")
+
+	_ = epicListCmd.RegisterFlagCompletionFunc("status", completeStatuses)
+	_ = epicListCmd.RegisterFlagCompletionFunc("assignee", completeUsers)
+	_ = epicListCmd.RegisterFlagCompletionFunc("priority", completePriorities)
 
 	epicCmd.AddCommand(epicListCmd)
 }
@@ -73,6 +87,10 @@ func runEpicList(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to search epics: %w", err)
 	}
 
+	if epicListProgress {
+		return printEpicProgressList(ctx, client, issues)
+	}
+
 	if JSONOutput() {
 		output, err := json.MarshalIndent(issues, "", "  ")
 		if err != nil {
@@ -140,6 +158,79 @@ func runEpicList(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// printEpicProgressList fetches child progress for each epic and prints the
+// epics with a progress column, or as JSON.
+func printEpicProgressList(ctx context.Context, client *api.Client, issues []IssueInfo) error {
+	keys := make([]string, len(issues))
+	for i, issue := range issues {
+		keys[i] = issue.Key
+	}
+
+	progress, err := fetchEpicProgress(ctx, client, keys)
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); ok {
+			return fmt.Errorf("API error: %w", apiErr)
+		}
+		return fmt.Errorf("failed to fetch epic progress: %w", err)
+	}
+
+	epics := make([]EpicSummary, len(issues))
+	for i, issue := range issues {
+		epics[i] = EpicSummary{IssueInfo: issue, Progress: progress[i]}
+	}
+
+	if JSONOutput() {
+		output, err := json.MarshalIndent(epics, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to format JSON: %w", err)
+		}
+		fmt.Println(string(output))
+		return nil
+	}
+
+	if len(epics) == 0 {
+		fmt.Println("No epics found.")
+		return nil
+	}
+
+	bold := color.New(color.Bold).SprintFunc()
+	faint := color.New(color.Faint).SprintFunc()
+	header := color.New(color.FgCyan, color.Bold).SprintFunc()
+
+	keyWidth, statusWidth, progressWidth := 8, 11, 8
+	for _, e := range epics {
+		keyWidth = max(keyWidth, width.StringWidth(e.Key))
+		statusWidth = max(statusWidth, width.StringWidth(e.Status))
+		progressWidth = max(progressWidth, width.StringWidth(formatEpicProgress(e.Progress)))
+	}
+
+	fmt.Printf("%s  %s  %s  %s  %s  %s\n",
+		header(width.PadRight("KEY", keyWidth)),
+		header(width.PadRight("STATUS", statusWidth)),
+		header(width.PadRight("PROGRESS", progressWidth)),
+		header(width.PadRight("POINTS", 9)),
+		header(width.PadRight("DUE", 10)),
+		header("SUMMARY"))
+
+	for _, e := range epics {
+		points := fmt.Sprintf("%s/%s", formatPoints(e.Progress.DonePoints), formatPoints(e.Progress.Points))
+		due := faint(width.PadRight("-", 10))
+		if e.Progress.LatestDueDate != "" {
+			due = width.PadRight(e.Progress.LatestDueDate, 10)
+		}
+
+		fmt.Printf("%s  %s  %s  %s  %s  %s\n",
+			bold(width.PadRight(e.Key, keyWidth)),
+			colorStatus(width.PadRight(e.Status, statusWidth), e.StatusCategory),
+			width.PadRight(formatEpicProgress(e.Progress), progressWidth),
+			width.PadRight(points, 9),
+			due,
+			width.Truncate(e.Summary, 50, "..."))
+	}
+
+	return nil
+}
+
 func buildEpicListJQL() string {
 	conditions := []string{
 		fmt.Sprintf("project = %s", Project()),
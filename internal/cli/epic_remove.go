@@ -56,6 +56,9 @@ func runEpicRemove(cmd *cobra.Command, args []string) error {
 		if err != nil {
 			return err
 		}
+		if err := checkBatchPolicy(len(issueKeys)); err != nil {
+			return err
+		}
 		if len(issueKeys) == 0 {
 			return fmt.Errorf("no issue keys provided via stdin")
 		}
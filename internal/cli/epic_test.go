@@ -21,6 +21,7 @@ func resetEpicListFlags() {
 	epicListStatus = ""
 	epicListAssignee = ""
 	epicListPriority = ""
+	epicListProgress = false
 	project = ""
 }
 
@@ -225,3 +226,86 @@ func TestRemoveIssuesFromEpic_APIError(t *testing.T) {
 		t.Errorf("expected status 400, got %d", apiErr.StatusCode)
 	}
 }
+
+func TestComputeEpicProgress(t *testing.T) {
+	three, five := 3.0, 5.0
+	children := []EpicChild{
+		{IssueInfo: IssueInfo{Key: "GCP-1", StatusCategory: "done"}, Points: &three, DueDate: "2026-03-01"},
+		{IssueInfo: IssueInfo{Key: "GCP-2", StatusCategory: "indeterminate"}, Points: &five, DueDate: "2026-04-15"},
+		{IssueInfo: IssueInfo{Key: "GCP-3", StatusCategory: "new"}},
+		{IssueInfo: IssueInfo{Key: "GCP-4", StatusCategory: "done"}},
+	}
+
+	p := computeEpicProgress(children)
+
+	if p.Total != 4 || p.Done != 2 || p.InProgress != 1 || p.ToDo != 1 {
+		t.Errorf("unexpected counts: %+v", p)
+	}
+	if p.Percent != 50 {
+		t.Errorf("expected 50%% done, got %d", p.Percent)
+	}
+	if p.Points != 8 || p.DonePoints != 3 || p.PointsPercent != 37 {
+		t.Errorf("unexpected points: %+v", p)
+	}
+	if p.Unestimated != 2 {
+		t.Errorf("expected 2 unestimated, got %d", p.Unestimated)
+	}
+	if p.LatestDueDate != "2026-04-15" {
+		t.Errorf("expected latest due 2026-04-15, got %q", p.LatestDueDate)
+	}
+}
+
+func TestComputeEpicProgress_Empty(t *testing.T) {
+	p := computeEpicProgress(nil)
+	if p.Total != 0 || p.Percent != 0 || p.PointsPercent != 0 || p.LatestDueDate != "" {
+		t.Errorf("expected zero progress, got %+v", p)
+	}
+}
+
+func TestGetEpicView_UsesParentJQL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/api/3/field":
+			_, _ = w.Write([]byte(`[{"id":"customfield_10016","name":"Story Points","custom":true}]`))
+		case "/rest/api/3/search/jql":
+			jql := r.URL.Query().Get("jql")
+			switch {
+			case jql == "key = GCP-50":
+				_, _ = w.Write([]byte(`{"issues":[{"key":"GCP-50","fields":{"summary":"Auth","status":{"name":"In Progress","statusCategory":{"key":"indeterminate"}},"issuetype":{"name":"Epic"}}}],"isLast":true}`))
+			case strings.HasPrefix(jql, parentJQL("GCP-50")):
+				fields := r.URL.Query().Get("fields")
+				if !strings.Contains(fields, "duedate") || !strings.Contains(fields, "customfield_10016") {
+					t.Errorf("expected duedate and points fields, got %s", fields)
+				}
+				_, _ = w.Write([]byte(`{"issues":[
+					{"key":"GCP-51","fields":{"summary":"Login","status":{"name":"Done","statusCategory":{"key":"done"}},"customfield_10016":2,"duedate":"2026-05-01"}},
+					{"key":"GCP-52","fields":{"summary":"Logout","status":{"name":"To Do","statusCategory":{"key":"new"}},"duedate":null}}
+				],"isLast":true}`))
+			default:
+				t.Errorf("unexpected jql %q", jql)
+			}
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	view, err := getEpicView(context.Background(), client, "GCP-50")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if view.Key != "GCP-50" || view.Summary != "Auth" {
+		t.Errorf("unexpected epic: %+v", view.IssueInfo)
+	}
+	if len(view.Children) != 2 || view.Children[0].DueDate != "2026-05-01" {
+		t.Fatalf("unexpected children: %+v", view.Children)
+	}
+	p := view.Progress
+	if p.Percent != 50 || p.Points != 2 || p.PointsPercent != 100 || p.Unestimated != 1 || p.LatestDueDate != "2026-05-01" {
+		t.Errorf("unexpected progress: %+v", p)
+	}
+}
@@ -0,0 +1,286 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/fatih/color"
+	"github.com/grantcarthew/ajira/internal/api"
+	"github.com/grantcarthew/ajira/internal/config"
+	"github.com/grantcarthew/ajira/internal/width"
+	"github.com/spf13/cobra"
+)
+
+// EpicView represents an epic with its child issues and progress.
+type EpicView struct {
+	IssueInfo
+	Progress EpicProgress `json:"progress"`
+	Children []EpicChild  `json:"children"`
+}
+
+// EpicChild is a child issue of an epic with its estimate and due date.
+type EpicChild struct {
+	IssueInfo
+	Points  *float64 `json:"points"`
+	DueDate string   `json:"dueDate,omitempty"`
+}
+
+// EpicProgress counts an epic's children by status category and sums their
+// story points. Percent is by issue count, PointsPercent by story points.
+type EpicProgress struct {
+	Total         int     `json:"total"`
+	Done          int     `json:"done"`
+	InProgress    int     `json:"inProgress"`
+	ToDo          int     `json:"toDo"`
+	Percent       int     `json:"percent"`
+	Points        float64 `json:"points"`
+	DonePoints    float64 `json:"donePoints"`
+	PointsPercent int     `json:"pointsPercent"`
+	Unestimated   int     `json:"unestimated"`
+	LatestDueDate string  `json:"latestDueDate,omitempty"`
+}
+
+var epicViewCmd = &cobra.Command{
+	Use:   "view <epic-key>",
+	Short: "View epic progress",
+	Long:  "Display an epic's child issues with progress by status category, story points, unestimated children, and the latest due date.",
+	Example: `  ajira epic view GCP-50
+  ajira epic view GCP-50 --json`,
+	Args:              cobra.ExactArgs(1),
+	SilenceUsage:      true,
+	ValidArgsFunction: completeFirstArg(completeIssueKeys),
+	RunE:              runEpicView,
+}
+
+func init() {
+	epicCmd.AddCommand(epicViewCmd)
+}
+
+func runEpicView(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	epicKey := args[0]
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(cfg)
+
+	view, err := getEpicView(ctx, client, epicKey)
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); ok {
+			return fmt.Errorf("API error: %w", apiErr)
+		}
+		return fmt.Errorf("failed to view epic: %w", err)
+	}
+
+	if JSONOutput() {
+		output, err := json.MarshalIndent(view, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to format JSON: %w", err)
+		}
+		fmt.Println(string(output))
+	} else {
+		printEpicView(view)
+	}
+
+	return nil
+}
+
+func getEpicView(ctx context.Context, client *api.Client, epicKey string) (*EpicView, error) {
+	issues, err := searchIssues(ctx, client, fmt.Sprintf("key = %s", epicKey), 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(issues) == 0 {
+		return nil, fmt.Errorf("issue not found: %s", epicKey)
+	}
+
+	pointsField, err := findStoryPointsField(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	children, err := fetchEpicChildren(ctx, client, epicKey, pointsField)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EpicView{
+		IssueInfo: issues[0],
+		Progress:  computeEpicProgress(children),
+		Children:  children,
+	}, nil
+}
+
+// fetchEpicChildren returns the direct children of an epic with their story
+// points and due dates.
+func fetchEpicChildren(ctx context.Context, client *api.Client, epicKey, pointsField string) ([]EpicChild, error) {
+	fields := issueListFields + ",duedate"
+	if pointsField != "" {
+		fields += "," + pointsField
+	}
+
+	values, err := searchIssueValues(ctx, client, parentJQL(epicKey)+" ORDER BY key ASC", fields, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	children := make([]EpicChild, len(values))
+	for i, v := range values {
+		children[i] = EpicChild{
+			IssueInfo: issueInfoFromValue(v),
+			Points:    fieldNumber(v.Fields.Raw, pointsField),
+			DueDate:   fieldString(v.Fields.Raw, "duedate"),
+		}
+	}
+	return children, nil
+}
+
+// fetchEpicProgress computes progress for many epics with bounded concurrency.
+// Results are returned in the order of keys.
+func fetchEpicProgress(ctx context.Context, client *api.Client, keys []string) ([]EpicProgress, error) {
+	pointsField, err := findStoryPointsField(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	progress := make([]EpicProgress, len(keys))
+	errs := make([]error, len(keys))
+	sem := make(chan struct{}, treeConcurrency)
+	var wg sync.WaitGroup
+
+	for i, key := range keys {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				errs[i] = ctx.Err()
+				return
+			}
+			defer func() { <-sem }()
+
+			children, err := fetchEpicChildren(ctx, client, key, pointsField)
+			if err != nil {
+				errs[i] = fmt.Errorf("%s: %w", key, err)
+				return
+			}
+			progress[i] = computeEpicProgress(children)
+		}()
+	}
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return progress, nil
+}
+
+// computeEpicProgress rolls up an epic's children. Due dates are ISO dates,
+// so the latest compares lexically.
+func computeEpicProgress(children []EpicChild) EpicProgress {
+	var p EpicProgress
+	for _, c := range children {
+		p.Total++
+		done := c.StatusCategory == "done"
+		switch c.StatusCategory {
+		case "done":
+			p.Done++
+		case "indeterminate":
+			p.InProgress++
+		default:
+			p.ToDo++
+		}
+
+		if c.Points == nil {
+			p.Unestimated++
+		} else {
+			p.Points += *c.Points
+			if done {
+				p.DonePoints += *c.Points
+			}
+		}
+
+		if c.DueDate > p.LatestDueDate {
+			p.LatestDueDate = c.DueDate
+		}
+	}
+
+	if p.Total > 0 {
+		p.Percent = p.Done * 100 / p.Total
+	}
+	if p.Points > 0 {
+		p.PointsPercent = int(p.DonePoints * 100 / p.Points)
+	}
+	return p
+}
+
+// formatEpicProgress renders a one-line progress summary.
+func formatEpicProgress(p EpicProgress) string {
+	return fmt.Sprintf("%d%% (%d/%d done)", p.Percent, p.Done, p.Total)
+}
+
+func printEpicView(view *EpicView) {
+	bold := color.New(color.Bold).SprintFunc()
+	header := color.New(color.FgCyan, color.Bold).SprintFunc()
+	faint := color.New(color.Faint).SprintFunc()
+
+	p := view.Progress
+	fmt.Printf("%s  %s  %s\n", bold(view.Key), colorStatus(view.Status, view.StatusCategory), view.Summary)
+	fmt.Printf("Progress:     %s\n", formatEpicProgress(p))
+	fmt.Printf("Issues:       %d to do, %d in progress, %d done\n", p.ToDo, p.InProgress, p.Done)
+	fmt.Printf("Points:       %s of %s done (%d%%)\n", formatPoints(p.DonePoints), formatPoints(p.Points), p.PointsPercent)
+	fmt.Printf("Unestimated:  %d\n", p.Unestimated)
+	latest := p.LatestDueDate
+	if latest == "" {
+		latest = "-"
+	}
+	fmt.Printf("Latest due:   %s\n", latest)
+
+	if len(view.Children) == 0 {
+		fmt.Println()
+		fmt.Println("No child issues.")
+		return
+	}
+
+	keyWidth, typeWidth, statusWidth := 3, 4, 6
+	for _, c := range view.Children {
+		keyWidth = max(keyWidth, width.StringWidth(c.Key))
+		typeWidth = max(typeWidth, width.StringWidth(c.Type))
+		statusWidth = max(statusWidth, width.StringWidth(c.Status))
+	}
+
+	fmt.Println()
+	fmt.Printf("%s  %s  %s  %s  %s  %s\n",
+		header(width.PadRight("KEY", keyWidth)),
+		header(width.PadRight("TYPE", typeWidth)),
+		header(width.PadRight("STATUS", statusWidth)),
+		header(width.PadRight("PTS", 4)),
+		header(width.PadRight("DUE", 10)),
+		header("SUMMARY"))
+
+	for _, c := range view.Children {
+		points := faint(width.PadRight("-", 4))
+		if c.Points != nil {
+			points = width.PadRight(formatPoints(*c.Points), 4)
+		}
+		due := faint(width.PadRight("-", 10))
+		if c.DueDate != "" {
+			due = width.PadRight(c.DueDate, 10)
+		}
+
+		fmt.Printf("%s  %s  %s  %s  %s  %s\n",
+			bold(width.PadRight(c.Key, keyWidth)),
+			width.PadRight(c.Type, typeWidth),
+			colorStatus(width.PadRight(c.Status, statusWidth), c.StatusCategory),
+			points,
+			due,
+			width.Truncate(c.Summary, 50, "..."))
+	}
+}
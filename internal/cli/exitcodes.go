@@ -1,7 +1,11 @@
 package cli
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"net"
 
 	"github.com/grantcarthew/ajira/internal/api"
@@ -9,12 +13,18 @@ import (
 
 // Exit codes for the CLI.
 const (
-	ExitSuccess   = 0 // Successful execution
-	ExitUserError = 1 // User/input error (invalid args, missing required values)
-	ExitAPIError  = 2 // API error (4xx/5xx responses, except auth)
-	ExitNetError  = 3 // Network/connection error
-	ExitAuthError = 4 // Authentication error (401, 403)
-	ExitPartial   = 5 // Partial failure in batch operations
+	ExitSuccess     = 0  // Successful execution
+	ExitUserError   = 1  // User/input error (invalid args, missing required values)
+	ExitAPIError    = 2  // API error (4xx/5xx responses, except auth)
+	ExitNetError    = 3  // Network/connection error
+	ExitAuthError   = 4  // Authentication error (401, 403)
+	ExitPartial     = 5  // Partial failure in batch operations
+	ExitPolicyError = 6  // Change blocked by the AJIRA_* policy
+	ExitNotFound    = 7  // Resource not found (404)
+	ExitValidation  = 8  // Request rejected as invalid (400)
+	ExitConflict    = 9  // Conflicting change (409)
+	ExitRateLimit   = 10 // Rate limit still exceeded after retries (429)
+	ExitTimeout     = 11 // Request timed out
 )
 
 // ExitError wraps an error with an exit code.
@@ -52,20 +62,40 @@ func ExitCodeFromError(err error) int {
 		return exitErr.Code
 	}
 
+	// Check for policy violations
+	var policyErr *api.PolicyError
+	if errors.As(err, &policyErr) {
+		return ExitPolicyError
+	}
+
 	// Check for API errors
 	var apiErr *api.APIError
 	if errors.As(err, &apiErr) {
 		switch apiErr.StatusCode {
 		case 401, 403:
 			return ExitAuthError
+		case 400:
+			return ExitValidation
+		case 404:
+			return ExitNotFound
+		case 409:
+			return ExitConflict
+		case 429:
+			return ExitRateLimit
 		default:
 			return ExitAPIError
 		}
 	}
 
-	// Check for network errors
+	// Check for timeouts before other network errors
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ExitTimeout
+	}
 	var netErr net.Error
 	if errors.As(err, &netErr) {
+		if netErr.Timeout() {
+			return ExitTimeout
+		}
 		return ExitNetError
 	}
 
@@ -78,3 +108,56 @@ func ExitCodeFromError(err error) int {
 	// Default to user error for unrecognised errors
 	return ExitUserError
 }
+
+// ErrorOutput is the JSON form of an error, printed to stderr with --json.
+// Code is the exit code; the other fields are set for API errors.
+type ErrorOutput struct {
+	Error       string            `json:"error"`
+	Code        int               `json:"code"`
+	Status      int               `json:"status,omitempty"`
+	Method      string            `json:"method,omitempty"`
+	Path        string            `json:"path,omitempty"`
+	Messages    []string          `json:"messages,omitempty"`
+	FieldErrors map[string]string `json:"field_errors,omitempty"`
+}
+
+// NewErrorOutput builds the JSON form of err.
+func NewErrorOutput(err error) ErrorOutput {
+	out := ErrorOutput{
+		Error: err.Error(),
+		Code:  ExitCodeFromError(err),
+	}
+
+	var apiErr *api.APIError
+	if errors.As(err, &apiErr) {
+		out.Status = apiErr.StatusCode
+		out.Method = apiErr.Method
+		out.Path = apiErr.Path
+		out.Messages = apiErr.Messages
+		if len(out.Messages) == 0 && apiErr.RawBody != "" {
+			out.Messages = []string{apiErr.RawBody}
+		}
+		out.FieldErrors = apiErr.Errors
+	}
+
+	var policyErr *api.PolicyError
+	if errors.As(err, &policyErr) {
+		out.Method = policyErr.Method
+		out.Path = policyErr.Path
+		out.Messages = []string{policyErr.Reason}
+	}
+
+	return out
+}
+
+// PrintError writes err to w, as an ErrorOutput object in JSON mode.
+func PrintError(w io.Writer, err error) {
+	if JSONOutput() {
+		output, jsonErr := json.MarshalIndent(NewErrorOutput(err), "", "  ")
+		if jsonErr == nil {
+			fmt.Fprintln(w, string(output))
+			return
+		}
+	}
+	fmt.Fprintf(w, "Error: %v\n", err)
+}
@@ -1,8 +1,13 @@
 package cli
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"net"
+	"strings"
 	"testing"
 
 	"github.com/grantcarthew/ajira/internal/api"
@@ -50,14 +55,29 @@ func TestExitCodeFromError(t *testing.T) {
 			expected: ExitAuthError,
 		},
 		{
-			name: "API error 404 returns API error",
+			name: "API error 404 returns not found",
 			err: &api.APIError{
 				StatusCode: 404,
 				Status:     "404 Not Found",
 				Method:     "GET",
 				Path:       "/test",
 			},
-			expected: ExitAPIError,
+			expected: ExitNotFound,
+		},
+		{
+			name:     "wrapped API error 400 returns validation error",
+			err:      fmt.Errorf("API error: %w", &api.APIError{StatusCode: 400, Status: "400 Bad Request"}),
+			expected: ExitValidation,
+		},
+		{
+			name:     "API error 409 returns conflict",
+			err:      &api.APIError{StatusCode: 409, Status: "409 Conflict"},
+			expected: ExitConflict,
+		},
+		{
+			name:     "API error 429 returns rate limit",
+			err:      &api.APIError{StatusCode: 429, Status: "429 Too Many Requests"},
+			expected: ExitRateLimit,
 		},
 		{
 			name: "API error 500 returns API error",
@@ -69,6 +89,21 @@ func TestExitCodeFromError(t *testing.T) {
 			},
 			expected: ExitAPIError,
 		},
+		{
+			name:     "wrapped policy error returns policy code",
+			err:      fmt.Errorf("failed to update issue: %w", &api.PolicyError{Method: "PUT", Path: "/issue/X-1", Reason: "read-only mode"}),
+			expected: ExitPolicyError,
+		},
+		{
+			name:     "deadline exceeded returns timeout",
+			err:      fmt.Errorf("executing request: %w", context.DeadlineExceeded),
+			expected: ExitTimeout,
+		},
+		{
+			name:     "network timeout returns timeout",
+			err:      &net.DNSError{Err: "i/o timeout", Name: "example.com", IsTimeout: true},
+			expected: ExitTimeout,
+		},
 		{
 			name:     "DNS error returns network error",
 			err:      &net.DNSError{Err: "no such host", Name: "example.com"},
@@ -145,4 +180,84 @@ func TestExitCodeConstants(t *testing.T) {
 	if ExitPartial != 5 {
 		t.Errorf("ExitPartial = %d, want 5", ExitPartial)
 	}
+	if ExitPolicyError != 6 || ExitNotFound != 7 || ExitValidation != 8 || ExitConflict != 9 || ExitRateLimit != 10 || ExitTimeout != 11 {
+		t.Errorf("exit codes 6-11 changed: %d %d %d %d %d %d", ExitPolicyError, ExitNotFound, ExitValidation, ExitConflict, ExitRateLimit, ExitTimeout)
+	}
+}
+
+func TestNewErrorOutput(t *testing.T) {
+	err := fmt.Errorf("API error: %w", &api.APIError{
+		StatusCode: 400,
+		Status:     "400 Bad Request",
+		Method:     "POST",
+		Path:       "/rest/api/3/issue",
+		Messages:   []string{"Invalid request"},
+		Errors:     map[string]string{"summary": "Summary is required"},
+	})
+
+	out := NewErrorOutput(err)
+	if out.Code != ExitValidation || out.Status != 400 || out.Method != "POST" || out.Path != "/rest/api/3/issue" {
+		t.Errorf("unexpected output: %+v", out)
+	}
+	if len(out.Messages) != 1 || out.FieldErrors["summary"] != "Summary is required" {
+		t.Errorf("unexpected messages: %v, %v", out.Messages, out.FieldErrors)
+	}
+
+	out = NewErrorOutput(errors.New("issue key is required"))
+	if out.Code != ExitUserError || out.Status != 0 || out.Error != "issue key is required" {
+		t.Errorf("unexpected output: %+v", out)
+	}
+}
+
+func TestPrintError(t *testing.T) {
+	err := &api.APIError{StatusCode: 404, Status: "404 Not Found", Method: "GET", Path: "/rest/api/3/issue/X-1"}
+
+	jsonOutput = false
+	var buf bytes.Buffer
+	PrintError(&buf, err)
+	if !strings.HasPrefix(buf.String(), "Error: GET /rest/api/3/issue/X-1: 404 Not Found") {
+		t.Errorf("unexpected text output: %q", buf.String())
+	}
+
+	jsonOutput = true
+	defer func() { jsonOutput = false }()
+	buf.Reset()
+	PrintError(&buf, err)
+
+	var out map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &out); err != nil {
+		t.Fatalf("expected JSON, got %q: %v", buf.String(), err)
+	}
+	if out["code"] != float64(ExitNotFound) || out["status"] != float64(404) || out["path"] != "/rest/api/3/issue/X-1" {
+		t.Errorf("unexpected JSON output: %v", out)
+	}
+	if _, ok := out["field_errors"]; ok {
+		t.Errorf("expected no field_errors, got %v", out)
+	}
+}
+
+func TestPrintBatchResults_ExitCodes(t *testing.T) {
+	ok := BatchResult{Key: "TEST-1", Success: true}
+	blocked := failedResult("TEST-2", fmt.Errorf("API error: %w", &api.PolicyError{Method: "PUT", Path: "/issue/TEST-2", Reason: "read-only mode"}))
+	// Jira text that happens to contain the policy wording is still an API error
+	lookalike := failedResult("TEST-3", &api.APIError{StatusCode: 400, Status: "400 Bad Request", Messages: []string{"blocked by policy: workflow"}})
+
+	tests := []struct {
+		name     string
+		results  []BatchResult
+		expected int
+	}{
+		{name: "blocked by policy", results: []BatchResult{ok, blocked}, expected: ExitPolicyError},
+		{name: "policy wording in Jira error", results: []BatchResult{ok, lookalike}, expected: ExitPartial},
+		{name: "all failed", results: []BatchResult{lookalike}, expected: ExitAPIError},
+		{name: "all succeeded", results: []BatchResult{ok}, expected: ExitSuccess},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if code := ExitCodeFromError(PrintBatchResults(tt.results)); code != tt.expected {
+				t.Errorf("exit code = %d, want %d", code, tt.expected)
+			}
+		})
+	}
 }
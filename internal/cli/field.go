@@ -6,10 +6,12 @@ import (
 	"fmt"
 	"os"
 	"sort"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/grantcarthew/ajira/internal/api"
 	"github.com/grantcarthew/ajira/internal/config"
+	"github.com/grantcarthew/ajira/internal/converter"
 	"github.com/spf13/cobra"
 )
 
@@ -150,3 +152,119 @@ func fetchFields(ctx context.Context, client *api.Client) ([]FieldInfo, error) {
 
 	return result, nil
 }
+
+// storyPointsFieldNames are the field names Jira Cloud uses for story points
+// in company-managed and team-managed projects respectively.
+var storyPointsFieldNames = []string{"Story Points", "Story point estimate"}
+
+// findStoryPointsField returns the ID of the story points field, or an empty
+// string if the instance has none.
+func findStoryPointsField(ctx context.Context, client *api.Client) (string, error) {
+	fields, err := fetchFields(ctx, client)
+	if err != nil {
+		return "", err
+	}
+	return fieldIDByName(fields, storyPointsFieldNames...), nil
+}
+
+// fieldIDByName returns the ID of the first field matching one of names,
+// in order of preference, or an empty string if none match.
+func fieldIDByName(fields []FieldInfo, names ...string) string {
+	for _, name := range names {
+		for _, f := range fields {
+			if strings.EqualFold(f.Name, name) {
+				return f.ID
+			}
+		}
+	}
+	return ""
+}
+
+// fieldNumber decodes a numeric field value from raw issue fields. Returns
+// nil if the field is absent, null, or not a number.
+func fieldNumber(raw map[string]json.RawMessage, fieldID string) *float64 {
+	if fieldID == "" {
+		return nil
+	}
+	var v *float64
+	if err := json.Unmarshal(raw[fieldID], &v); err != nil {
+		return nil
+	}
+	return v
+}
+
+// fieldString decodes a string field value from raw issue fields. Returns
+// an empty string if the field is absent, null, or not a string.
+func fieldString(raw map[string]json.RawMessage, fieldID string) string {
+	var v string
+	if err := json.Unmarshal(raw[fieldID], &v); err != nil {
+		return ""
+	}
+	return v
+}
+
+// findField returns the field whose ID or name matches nameOrID, preferring
+// an exact ID match.
+func findField(fields []FieldInfo, nameOrID string) (FieldInfo, bool) {
+	for _, f := range fields {
+		if f.ID == nameOrID {
+			return f, true
+		}
+	}
+	for _, f := range fields {
+		if strings.EqualFold(f.Name, nameOrID) {
+			return f, true
+		}
+	}
+	return FieldInfo{}, false
+}
+
+// fieldText renders a field value as plain text. Strings are returned as-is,
+// rich text (ADF) is converted to Markdown, options and users use their
+// display value, and arrays are joined with commas.
+func fieldText(raw json.RawMessage) string {
+	if len(raw) == 0 || string(raw) == "null" {
+		return ""
+	}
+
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+
+	var list []json.RawMessage
+	if err := json.Unmarshal(raw, &list); err == nil {
+		parts := make([]string, 0, len(list))
+		for _, item := range list {
+			if text := fieldText(item); text != "" {
+				parts = append(parts, text)
+			}
+		}
+		return strings.Join(parts, ", ")
+	}
+
+	var obj struct {
+		Type        string `json:"type"`
+		Value       string `json:"value"`
+		Name        string `json:"name"`
+		DisplayName string `json:"displayName"`
+	}
+	if err := json.Unmarshal(raw, &obj); err != nil {
+		return strings.TrimSpace(string(raw))
+	}
+	switch {
+	case obj.Type == "doc":
+		md, err := converter.ADFToMarkdown(raw)
+		if err != nil {
+			return ""
+		}
+		return strings.TrimSpace(md)
+	case obj.Value != "":
+		return obj.Value
+	case obj.DisplayName != "":
+		return obj.DisplayName
+	case obj.Name != "":
+		return obj.Name
+	}
+	return ""
+}
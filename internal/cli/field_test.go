@@ -170,3 +170,101 @@ func TestFetchFields_APIError(t *testing.T) {
 		t.Errorf("expected status 403, got %d", apiErr.StatusCode)
 	}
 }
+
+func TestGetFieldValues(t *testing.T) {
+	var mappings []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/api/3/field":
+			_, _ = w.Write([]byte(`[{"id":"priority","name":"Priority","custom":false},{"id":"customfield_10050","name":"Region","custom":true}]`))
+		case "/rest/api/3/field/customfield_10050/context":
+			_, _ = w.Write([]byte(`{"isLast":true,"values":[{"id":"100","name":"Default"},{"id":"200","name":"Bugs only"}]}`))
+		case "/rest/api/3/project/GCP":
+			_, _ = w.Write([]byte(`{"id":"10000","key":"GCP"}`))
+		case "/rest/api/3/issue/createmeta/GCP/issuetypes":
+			_, _ = w.Write([]byte(`{"issueTypes":[{"id":"1","name":"Task"},{"id":"2","name":"Bug"}]}`))
+		case "/rest/api/3/field/customfield_10050/context/mapping":
+			var req struct {
+				Mappings []struct {
+					IssueTypeID string `json:"issueTypeId"`
+				} `json:"mappings"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			var values []string
+			for _, m := range req.Mappings {
+				mappings = append(mappings, m.IssueTypeID)
+				contextID := `"100"`
+				if m.IssueTypeID == "2" {
+					contextID = `"200"`
+				}
+				values = append(values, `{"projectId":"10000","issueTypeId":"`+m.IssueTypeID+`","contextId":`+contextID+`}`)
+			}
+			_, _ = w.Write([]byte(`{"isLast":true,"values":[` + strings.Join(values, ",") + `]}`))
+		case "/rest/api/3/field/customfield_10050/context/100/option":
+			_, _ = w.Write([]byte(`{"isLast":true,"values":[
+				{"id":"1","value":"EMEA"},
+				{"id":"2","value":"APAC","disabled":true},
+				{"id":"3","value":"London","optionId":"1"},
+				{"id":"4","value":"Paris","optionId":"1"}
+			]}`))
+		case "/rest/api/3/field/customfield_10050/context/200/option":
+			_, _ = w.Write([]byte(`{"isLast":true,"values":[{"id":"9","value":"Global"}]}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	values, err := getFieldValues(context.Background(), client, "region", "", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if values.Field.ID != "customfield_10050" || len(values.Contexts) != 2 {
+		t.Fatalf("unexpected result: %+v", values)
+	}
+	opts := values.Contexts[0].Options
+	if len(opts) != 2 || opts[0].Value != "EMEA" || !opts[1].Disabled {
+		t.Fatalf("unexpected top-level options: %+v", opts)
+	}
+	if len(opts[0].Children) != 2 || opts[0].Children[1].ID != "4" || opts[0].Children[1].Value != "Paris" {
+		t.Errorf("expected cascading children under EMEA, got %+v", opts[0].Children)
+	}
+	if len(mappings) != 0 {
+		t.Errorf("expected no context mapping without a project, got %v", mappings)
+	}
+
+	values, err = getFieldValues(context.Background(), client, "customfield_10050", "GCP", "bug")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(mappings) != 1 || mappings[0] != "2" {
+		t.Errorf("expected mapping for Bug only, got %v", mappings)
+	}
+	if len(values.Contexts) != 1 || values.Contexts[0].ID != "200" || values.Contexts[0].Options[0].Value != "Global" {
+		t.Errorf("unexpected contexts: %+v", values.Contexts)
+	}
+
+	if _, err := getFieldValues(context.Background(), client, "customfield_10050", "GCP", "Epic"); err == nil || !strings.Contains(err.Error(), "issue type not found") {
+		t.Errorf("expected issue type error, got %v", err)
+	}
+}
+
+func TestGetFieldValues_SystemField(t *testing.T) {
+	server := jsonServer(map[string]any{
+		"/rest/api/3/field": `[{"id":"priority","name":"Priority","custom":false},{"id":"customfield_10050","name":"Region","custom":true}]`,
+	})
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	if _, err := getFieldValues(context.Background(), client, "Priority", "", ""); err == nil || !strings.Contains(err.Error(), "system field") {
+		t.Errorf("expected system field error, got %v", err)
+	}
+	if _, err := getFieldValues(context.Background(), client, "Nope", "", ""); err == nil || !strings.Contains(err.Error(), "field not found") {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
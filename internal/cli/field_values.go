@@ -0,0 +1,345 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/grantcarthew/ajira/internal/api"
+	"github.com/grantcarthew/ajira/internal/config"
+	"github.com/grantcarthew/ajira/internal/jira"
+	"github.com/spf13/cobra"
+)
+
+// FieldValues lists the allowed option values of a custom field per context.
+type FieldValues struct {
+	Field    FieldInfo           `json:"field"`
+	Contexts []FieldContextValue `json:"contexts"`
+}
+
+// FieldContextValue is a field context with its options.
+type FieldContextValue struct {
+	ID      string        `json:"id"`
+	Name    string        `json:"name"`
+	Options []FieldOption `json:"options"`
+}
+
+// FieldOption is an allowed value. Children holds the second level of a
+// cascading select.
+type FieldOption struct {
+	ID       string        `json:"id"`
+	Value    string        `json:"value"`
+	Disabled bool          `json:"disabled,omitempty"`
+	Children []FieldOption `json:"children,omitempty"`
+}
+
+// fieldContextResponse matches the paginated field context API response.
+type fieldContextResponse struct {
+	IsLast bool `json:"isLast"`
+	Values []struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"values"`
+}
+
+// fieldContextMappingResponse matches the project and issue type context
+// mapping API response. ContextID is null when no context applies.
+type fieldContextMappingResponse struct {
+	IsLast bool `json:"isLast"`
+	Values []struct {
+		ProjectID   string  `json:"projectId"`
+		IssueTypeID string  `json:"issueTypeId"`
+		ContextID   *string `json:"contextId"`
+	} `json:"values"`
+}
+
+// fieldOptionResponse matches the paginated context option API response.
+// OptionID is the parent option of a cascading child.
+type fieldOptionResponse struct {
+	IsLast bool `json:"isLast"`
+	Values []struct {
+		ID       string `json:"id"`
+		Value    string `json:"value"`
+		OptionID string `json:"optionId"`
+		Disabled bool   `json:"disabled"`
+	} `json:"values"`
+}
+
+var fieldValuesType string
+
+var fieldValuesCmd = &cobra.Command{
+	Use:   "values <field>",
+	Short: "List allowed field values",
+	Long: `List the option values of a select, multi-select, or cascading custom field, with option IDs.
+The field is given by name or ID. With -p (or JIRA_PROJECT), only contexts that apply to the
+project are shown; --type narrows further to one issue type. Without a project, all contexts are listed.`,
+	Example: `  ajira field values Severity -p GCP             # Contexts applying to GCP
+  ajira field values customfield_10050 -t Bug    # Only the Bug context in JIRA_PROJECT
+  ajira field values "Region" --json             # Cascading options include children`,
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE:         runFieldValues,
+}
+
+func init() {
+	fieldValuesCmd.Flags().StringVarP(&fieldValuesType, "type", "t", "", "Issue type to resolve the context for (requires a project)")
+
+	_ = fieldValuesCmd.RegisterFlagCompletionFunc("type", completeIssueTypes)
+
+	fieldCmd.AddCommand(fieldValuesCmd)
+}
+
+func runFieldValues(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	projectKey := Project()
+	if fieldValuesType != "" && projectKey == "" {
+		return fmt.Errorf("--type requires a project: use -p flag or set JIRA_PROJECT environment variable")
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(cfg)
+
+	values, err := getFieldValues(ctx, client, args[0], projectKey, fieldValuesType)
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); ok {
+			return fmt.Errorf("API error: %w", apiErr)
+		}
+		return fmt.Errorf("failed to fetch field values: %w", err)
+	}
+
+	if JSONOutput() {
+		output, err := json.MarshalIndent(values, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to format JSON: %w", err)
+		}
+		fmt.Println(string(output))
+	} else {
+		printFieldValues(values)
+	}
+
+	return nil
+}
+
+func getFieldValues(ctx context.Context, client *api.Client, nameOrID, projectKey, issueType string) (*FieldValues, error) {
+	fields, err := fetchFields(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	field, ok := findField(fields, nameOrID)
+	if !ok {
+		return nil, fmt.Errorf("field not found: %s", nameOrID)
+	}
+	if !field.Custom {
+		return nil, fmt.Errorf("%s is a system field; only custom fields have option contexts", field.Name)
+	}
+
+	contexts, err := fetchFieldContexts(ctx, client, field.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if projectKey != "" {
+		applicable, err := fieldContextsFor(ctx, client, field.ID, projectKey, issueType)
+		if err != nil {
+			return nil, err
+		}
+		var filtered []FieldContextValue
+		for _, c := range contexts {
+			if applicable[c.ID] {
+				filtered = append(filtered, c)
+			}
+		}
+		contexts = filtered
+	}
+
+	result := &FieldValues{Field: field, Contexts: []FieldContextValue{}}
+	for _, c := range contexts {
+		options, err := fetchFieldOptions(ctx, client, field.ID, c.ID)
+		if err != nil {
+			return nil, err
+		}
+		c.Options = options
+		result.Contexts = append(result.Contexts, c)
+	}
+	return result, nil
+}
+
+// fetchFieldContexts lists all contexts of a custom field.
+func fetchFieldContexts(ctx context.Context, client *api.Client, fieldID string) ([]FieldContextValue, error) {
+	var contexts []FieldContextValue
+	startAt := 0
+	const maxPages = 100
+
+	for range maxPages {
+		body, err := client.Get(ctx, fmt.Sprintf("/field/%s/context?startAt=%d&maxResults=50", url.PathEscape(fieldID), startAt))
+		if err != nil {
+			return nil, err
+		}
+
+		var resp fieldContextResponse
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return nil, fmt.Errorf("failed to parse response: %w", err)
+		}
+
+		for _, v := range resp.Values {
+			contexts = append(contexts, FieldContextValue{ID: v.ID, Name: v.Name})
+		}
+
+		startAt += len(resp.Values)
+		if resp.IsLast || len(resp.Values) == 0 {
+			break
+		}
+	}
+
+	return contexts, nil
+}
+
+// fieldContextsFor returns the IDs of the contexts that apply to a project,
+// for one issue type or for every issue type in the project.
+func fieldContextsFor(ctx context.Context, client *api.Client, fieldID, projectKey, issueType string) (map[string]bool, error) {
+	body, err := client.Get(ctx, "/project/"+url.PathEscape(projectKey))
+	if err != nil {
+		return nil, err
+	}
+	var project struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(body, &project); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	issueTypes, err := jira.GetIssueTypes(ctx, client, projectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	type mapping struct {
+		ProjectID   string `json:"projectId"`
+		IssueTypeID string `json:"issueTypeId"`
+	}
+	var mappings []mapping
+	for _, t := range issueTypes {
+		if issueType == "" || strings.EqualFold(t.Name, issueType) {
+			mappings = append(mappings, mapping{ProjectID: project.ID, IssueTypeID: t.ID})
+		}
+	}
+	if len(mappings) == 0 {
+		return nil, fmt.Errorf("issue type not found in %s: %s", projectKey, issueType)
+	}
+
+	reqBody, err := json.Marshal(map[string]any{"mappings": mappings})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	// The mapping lookup is a read, so the api package exempts it from the
+	// policy and journal
+	body, err = client.Post(ctx, fmt.Sprintf("/field/%s/context/mapping", url.PathEscape(fieldID)), reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp fieldContextMappingResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	ids := make(map[string]bool)
+	for _, v := range resp.Values {
+		if v.ContextID != nil {
+			ids[*v.ContextID] = true
+		}
+	}
+	return ids, nil
+}
+
+// fetchFieldOptions lists a context's options, nesting cascading children
+// under their parent option.
+func fetchFieldOptions(ctx context.Context, client *api.Client, fieldID, contextID string) ([]FieldOption, error) {
+	options := []FieldOption{}
+	index := make(map[string]int)
+	type child struct {
+		parent string
+		option FieldOption
+	}
+	var children []child
+
+	startAt := 0
+	const maxPages = 100
+
+	for range maxPages {
+		path := fmt.Sprintf("/field/%s/context/%s/option?startAt=%d&maxResults=100",
+			url.PathEscape(fieldID), url.PathEscape(contextID), startAt)
+		body, err := client.Get(ctx, path)
+		if err != nil {
+			return nil, err
+		}
+
+		var resp fieldOptionResponse
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return nil, fmt.Errorf("failed to parse response: %w", err)
+		}
+
+		for _, v := range resp.Values {
+			option := FieldOption{ID: v.ID, Value: v.Value, Disabled: v.Disabled}
+			if v.OptionID != "" {
+				children = append(children, child{parent: v.OptionID, option: option})
+				continue
+			}
+			index[v.ID] = len(options)
+			options = append(options, option)
+		}
+
+		startAt += len(resp.Values)
+		if resp.IsLast || len(resp.Values) == 0 {
+			break
+		}
+	}
+
+	for _, c := range children {
+		if i, ok := index[c.parent]; ok {
+			options[i].Children = append(options[i].Children, c.option)
+		}
+	}
+	return options, nil
+}
+
+func printFieldValues(values *FieldValues) {
+	fmt.Printf("%s (%s)\n", values.Field.Name, values.Field.ID)
+
+	if len(values.Contexts) == 0 {
+		fmt.Println()
+		fmt.Println("No applicable contexts found.")
+		return
+	}
+
+	for _, c := range values.Contexts {
+		fmt.Println()
+		fmt.Printf("Context: %s (%s)\n", c.Name, c.ID)
+		if len(c.Options) == 0 {
+			fmt.Println("  No options (free-form field)")
+			continue
+		}
+		for _, o := range c.Options {
+			printFieldOption(o, "  ")
+			for _, child := range o.Children {
+				printFieldOption(child, "    ")
+			}
+		}
+	}
+}
+
+func printFieldOption(o FieldOption, indent string) {
+	suffix := ""
+	if o.Disabled {
+		suffix = "  (disabled)"
+	}
+	fmt.Printf("%s%-8s %s%s\n", indent, o.ID, o.Value, suffix)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/grantcarthew/ajira/internal/api"
 	"github.com/grantcarthew/ajira/internal/config"
+	"github.com/grantcarthew/ajira/internal/journal"
 	"github.com/spf13/cobra"
 )
 
@@ -15,20 +16,28 @@ type assigneeRequest struct {
 	AccountID *string `json:"accountId"`
 }
 
-var assignStdin bool
+var (
+	assignStdin bool
+	assignJQL   string
+	assignYes   bool
+)
 
 var issueAssignCmd = &cobra.Command{
 	Use:   "assign <issue-key> <user>",
 	Short: "Assign issue",
-	Long:  "Assign an issue to a user. Use 'me', 'unassigned', or --stdin for batch.",
+	Long:  "Assign an issue to a user. Use 'me', 'unassigned', or --stdin or --jql for batch.",
 	Example: `  ajira issue assign PROJ-123 me                   # Assign to yourself
   ajira issue assign PROJ-123 user@example.com     # Assign by email
   ajira issue assign PROJ-123 unassigned           # Remove assignee
-  echo -e "PROJ-1\nPROJ-2" | ajira issue assign --stdin me  # Batch assign`,
+  echo -e "PROJ-1\nPROJ-2" | ajira issue assign --stdin me  # Batch assign
+  ajira issue assign --jql "assignee = olduser@example.com AND resolution is EMPTY" me --dry-run`,
 	Args: func(cmd *cobra.Command, args []string) error {
-		if assignStdin {
+		if assignStdin && assignJQL != "" {
+			return fmt.Errorf("cannot use --stdin with --jql")
+		}
+		if assignStdin || assignJQL != "" {
 			if len(args) != 1 {
-				return fmt.Errorf("with --stdin, requires exactly 1 argument: <user>")
+				return fmt.Errorf("with --stdin or --jql, requires exactly 1 argument: <user>")
 			}
 		} else {
 			if len(args) != 2 {
@@ -37,15 +46,29 @@ var issueAssignCmd = &cobra.Command{
 		}
 		return nil
 	},
-	SilenceUsage: true,
-	RunE:         runIssueAssign,
+	SilenceUsage:      true,
+	ValidArgsFunction: completeIssueAssign,
+	RunE:              runIssueAssign,
 }
 
 func init() {
 	issueAssignCmd.Flags().BoolVar(&assignStdin, "stdin", false, "Read issue keys from stdin (one per line)")
+	issueAssignCmd.Flags().StringVar(&assignJQL, "jql", "", "Assign all issues matching a JQL query")
+	issueAssignCmd.Flags().BoolVar(&assignYes, "yes", false, "Confirm changes to more than 50 issues matched by --jql")
 	issueCmd.AddCommand(issueAssignCmd)
 }
 
+// completeIssueAssign completes the issue key, then the user.
+func completeIssueAssign(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	switch len(args) {
+	case 0:
+		return completeIssueKeys(cmd, args, toComplete)
+	case 1:
+		return completeUsers(cmd, args, toComplete)
+	}
+	return nil, cobra.ShellCompDirectiveNoFileComp
+}
+
 func runIssueAssign(cmd *cobra.Command, args []string) error {
 	ctx := cmd.Context()
 
@@ -56,16 +79,27 @@ func runIssueAssign(cmd *cobra.Command, args []string) error {
 
 	client := api.NewClient(cfg)
 
-	// Determine user argument position based on --stdin
+	// Determine user argument position based on --stdin and --jql
 	var userArg string
 	var issueKeys []string
+	var matched []issueValue
 
-	if assignStdin {
+	if assignJQL != "" {
+		userArg = args[0]
+		matched, err = resolveJQLIssues(ctx, client, assignJQL, "summary,assignee", assignYes)
+		if err != nil {
+			return err
+		}
+		issueKeys = issueValueKeys(matched)
+	} else if assignStdin {
 		userArg = args[0]
 		issueKeys, err = ReadKeysFromStdin()
 		if err != nil {
 			return err
 		}
+		if err := checkBatchPolicy(len(issueKeys)); err != nil {
+			return err
+		}
 		if len(issueKeys) == 0 {
 			return fmt.Errorf("no issue keys provided via stdin")
 		}
@@ -86,7 +120,15 @@ func runIssueAssign(cmd *cobra.Command, args []string) error {
 		if accountID == nil {
 			assignee = "unassigned"
 		}
-		if len(issueKeys) == 1 {
+		if assignJQL != "" {
+			var previews []IssuePreview
+			for _, issue := range matched {
+				if changes := assignChanges(issue, accountID, assignee); len(changes) > 0 {
+					previews = append(previews, IssuePreview{Key: issue.Key, Summary: issue.Fields.Summary, Changes: changes})
+				}
+			}
+			printIssuePreviews(previews, len(matched))
+		} else if len(issueKeys) == 1 {
 			PrintDryRun(fmt.Sprintf("assign %s to %s", issueKeys[0], assignee))
 		} else {
 			PrintDryRunBatch(issueKeys, fmt.Sprintf("assign to %s", assignee))
@@ -95,8 +137,12 @@ func runIssueAssign(cmd *cobra.Command, args []string) error {
 	}
 
 	// Single issue assignment
-	if len(issueKeys) == 1 {
-		err = assignIssue(ctx, client, issueKeys[0], accountID)
+	if len(issueKeys) == 1 && assignJQL == "" {
+		assignCtx, err := undoContext(ctx, client, journal.KindAssign, issueKeys[0], []string{"assignee"}, nil)
+		if err != nil {
+			return err
+		}
+		err = assignIssue(assignCtx, client, issueKeys[0], accountID)
 		if err != nil {
 			return err
 		}
@@ -116,10 +162,17 @@ func runIssueAssign(cmd *cobra.Command, args []string) error {
 
 	// Batch assignment
 	var results []BatchResult
-	for _, key := range issueKeys {
-		err := assignIssue(ctx, client, key, accountID)
+	for i, key := range issueKeys {
+		var raw map[string]json.RawMessage
+		if matched != nil {
+			raw = matched[i].Fields.Raw
+		}
+		assignCtx, err := undoContext(ctx, client, journal.KindAssign, key, []string{"assignee"}, raw)
+		if err == nil {
+			err = assignIssue(assignCtx, client, key, accountID)
+		}
 		if err != nil {
-			results = append(results, BatchResult{Key: key, Success: false, Error: err.Error()})
+			results = append(results, failedResult(key, err))
 		} else {
 			results = append(results, BatchResult{Key: key, Success: true})
 		}
@@ -140,3 +193,20 @@ func assignIssue(ctx context.Context, client *api.Client, key string, accountID
 	_, err = client.Put(ctx, path, body)
 	return err
 }
+
+// assignChanges returns the assignee change for an issue, or nothing if it
+// is already assigned to accountID.
+func assignChanges(issue issueValue, accountID *string, assignee string) []FieldChange {
+	current := issue.Fields.Assignee
+	switch {
+	case current == nil && accountID == nil:
+		return nil
+	case current != nil && accountID != nil && current.AccountID == *accountID:
+		return nil
+	}
+	from := ""
+	if current != nil {
+		from = current.DisplayName
+	}
+	return []FieldChange{{Field: "assignee", From: from, To: assignee}}
+}
@@ -99,16 +99,16 @@ func (w *countingWriter) Write(p []byte) (int, error) {
 
 // calcMultipartSize does a dry run through the multipart writer to compute the
 // exact Content-Length without reading file content. File sizes are added
-// directly from the provided FileInfo slice. Returns total byte count and the
-// boundary string to reuse on the real writer.
-func calcMultipartSize(filePaths []string, infos []os.FileInfo) (int64, string, error) {
+// directly from the provided sizes. Returns total byte count and the boundary
+// string to reuse on the real writer.
+func calcMultipartSize(filenames []string, sizes []int64) (int64, string, error) {
 	cw := &countingWriter{}
 	mw := multipart.NewWriter(cw)
-	for i, fp := range filePaths {
-		if _, err := mw.CreateFormFile("file", filepath.Base(fp)); err != nil {
+	for i, name := range filenames {
+		if _, err := mw.CreateFormFile("file", name); err != nil {
 			return 0, "", err
 		}
-		cw.n += infos[i].Size()
+		cw.n += sizes[i]
 	}
 	if err := mw.Close(); err != nil {
 		return 0, "", err
@@ -118,17 +118,19 @@ func calcMultipartSize(filePaths []string, infos []os.FileInfo) (int64, string,
 
 func uploadAttachments(ctx context.Context, client *api.Client, issueKey string, filePaths []string) ([]AttachmentInfo, error) {
 	// Stat all files upfront so we can compute Content-Length before streaming.
-	infos := make([]os.FileInfo, len(filePaths))
+	filenames := make([]string, len(filePaths))
+	sizes := make([]int64, len(filePaths))
 	for i, fp := range filePaths {
 		fi, err := os.Stat(fp)
 		if err != nil {
 			return nil, fmt.Errorf("cannot stat %s: %w", fp, err)
 		}
-		infos[i] = fi
+		filenames[i] = filepath.Base(fp)
+		sizes[i] = fi.Size()
 	}
 
 	// Dry-run: measure exact multipart body size and capture the boundary.
-	totalSize, boundary, err := calcMultipartSize(filePaths, infos)
+	totalSize, boundary, err := calcMultipartSize(filenames, sizes)
 	if err != nil {
 		return nil, fmt.Errorf("failed to calculate upload size: %w", err)
 	}
@@ -141,8 +143,8 @@ func uploadAttachments(ctx context.Context, client *api.Client, issueKey string,
 			pw.CloseWithError(err)
 			return
 		}
-		for _, fp := range filePaths {
-			part, err := mw.CreateFormFile("file", filepath.Base(fp))
+		for i, fp := range filePaths {
+			part, err := mw.CreateFormFile("file", filenames[i])
 			if err != nil {
 				pw.CloseWithError(err)
 				return
@@ -36,9 +36,10 @@ var issueAttachmentListCmd = &cobra.Command{
 	Long:  "List all attachments for an issue with ID, filename, size, author, and date.",
 	Example: `  ajira issue attachment list PROJ-123          # List all attachments
   ajira issue attachment list PROJ-123 --json   # JSON output`,
-	Args:         cobra.ExactArgs(1),
-	SilenceUsage: true,
-	RunE:         runIssueAttachmentList,
+	Args:              cobra.ExactArgs(1),
+	SilenceUsage:      true,
+	ValidArgsFunction: completeFirstArg(completeIssueKeys),
+	RunE:              runIssueAttachmentList,
 }
 
 func init() {
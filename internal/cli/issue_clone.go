@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/grantcarthew/ajira/internal/api"
 	"github.com/grantcarthew/ajira/internal/config"
@@ -19,23 +20,47 @@ type CloneResult struct {
 	ClonedID    string `json:"clonedId"`
 	Linked      bool   `json:"linked"`
 	LinkType    string `json:"linkType,omitempty"`
+
+	// Deep clone results (--deep).
+	Subtasks     []string `json:"subtasks,omitempty"`
+	Attachments  int      `json:"attachments,omitempty"`
+	Comments     int      `json:"comments,omitempty"`
+	Links        int      `json:"links,omitempty"`
+	CustomFields int      `json:"customFields,omitempty"`
+	Warnings     []string `json:"warnings,omitempty"`
 }
 
 // cloneSourceResponse matches the Jira issue API response for cloning.
+// Names maps field IDs to display names (expand=names).
 type cloneSourceResponse struct {
 	Key    string            `json:"key"`
 	Fields cloneSourceFields `json:"fields"`
+	Names  map[string]string `json:"names"`
 }
 
 type cloneSourceFields struct {
-	Summary     string          `json:"summary"`
-	Description json.RawMessage `json:"description"`
-	IssueType   *issueType      `json:"issuetype"`
-	Priority    *priorityField  `json:"priority"`
-	Assignee    *userField      `json:"assignee"`
-	Reporter    *userField      `json:"reporter"`
-	Labels      []string        `json:"labels"`
-	Project     *projectField   `json:"project"`
+	Summary     string            `json:"summary"`
+	Description json.RawMessage   `json:"description"`
+	IssueType   *issueType        `json:"issuetype"`
+	Priority    *priorityField    `json:"priority"`
+	Assignee    *userField        `json:"assignee"`
+	Reporter    *userField        `json:"reporter"`
+	Labels      []string          `json:"labels"`
+	Project     *projectField     `json:"project"`
+	Subtasks    []issueValue      `json:"subtasks"`
+	IssueLinks  []issueLink       `json:"issuelinks"`
+	Attachment  []attachmentValue `json:"attachment"`
+
+	// Raw holds every returned field by ID, for copying custom fields.
+	Raw map[string]json.RawMessage `json:"-"`
+}
+
+func (f *cloneSourceFields) UnmarshalJSON(data []byte) error {
+	type plain cloneSourceFields
+	if err := json.Unmarshal(data, (*plain)(f)); err != nil {
+		return err
+	}
+	return json.Unmarshal(data, &f.Raw)
 }
 
 // cloneCreateRequest represents the request body for creating a cloned issue.
@@ -52,6 +77,30 @@ type cloneCreateFields struct {
 	Labels      []string        `json:"labels,omitempty"`
 	Assignee    *accountID      `json:"assignee,omitempty"`
 	Reporter    *accountID      `json:"reporter,omitempty"`
+
+	TimeTracking map[string]string `json:"timetracking,omitempty"`
+	DueDate      string            `json:"duedate,omitempty"`
+	Parent       *issueRef         `json:"parent,omitempty"`
+
+	// Custom holds custom field values keyed by field ID.
+	Custom map[string]json.RawMessage `json:"-"`
+}
+
+func (f cloneCreateFields) MarshalJSON() ([]byte, error) {
+	type plain cloneCreateFields
+	data, err := json.Marshal(plain(f))
+	if err != nil || len(f.Custom) == 0 {
+		return data, err
+	}
+
+	var merged map[string]json.RawMessage
+	if err := json.Unmarshal(data, &merged); err != nil {
+		return nil, err
+	}
+	for id, value := range f.Custom {
+		merged[id] = value
+	}
+	return json.Marshal(merged)
 }
 
 type accountID struct {
@@ -59,14 +108,18 @@ type accountID struct {
 }
 
 var (
-	cloneSummary  string
-	cloneAssignee string
-	cloneReporter string
-	clonePriority string
-	cloneType     string
-	cloneLabels   []string
-	cloneLink     string
-	cloneLinkSet  bool
+	cloneSummary   string
+	cloneAssignee  string
+	cloneReporter  string
+	clonePriority  string
+	cloneType      string
+	cloneLabels    []string
+	cloneLink      string
+	cloneLinkSet   bool
+	cloneEstimate  string
+	cloneRemaining string
+	cloneDue       string
+	cloneDeep      []string
 )
 
 const defaultLinkType = "Clones"
@@ -74,14 +127,25 @@ const defaultLinkType = "Clones"
 var issueCloneCmd = &cobra.Command{
 	Use:   "clone <issue-key>",
 	Short: "Clone issue",
-	Long:  "Clone an issue with the same fields. Override with -s, -a, -t, -P. Use --link to link to original.",
+	Long: `Clone an issue with the same fields. Override with -s, -a, -t, -P. Due date and estimates are
+only set with --due, --estimate, and --remaining. Use --link to link to original.
+
+Use --deep to also copy subtasks, attachments, comments, links, and custom fields, or
+--deep=<parts> to pick some of them. Subtasks are recreated under the clone with their core
+fields, comments are posted with the original author and date, and attachments are streamed
+from the original without touching disk. Custom fields not on the target create screen, and
+options with no match in the target project, are skipped with a warning.`,
 	Example: `  ajira issue clone PROJ-123                        # Clone with same fields
   ajira issue clone PROJ-123 -s "New summary"      # Override summary
   ajira issue clone PROJ-123 --link                # Link to original
   ajira issue clone PROJ-123 --link Duplicate      # Link with specific type
-  ajira issue clone PROJ-123 -p OTHER              # Clone to different project`,
-	Args:         cobra.ExactArgs(1),
-	SilenceUsage: true,
+  ajira issue clone PROJ-123 -p OTHER              # Clone to different project
+  ajira issue clone PROJ-123 --due +2w --estimate 3d  # New due date and estimate
+  ajira issue clone PROJ-123 --deep                # Copy subtasks, attachments, comments, links, fields
+  ajira issue clone PROJ-123 --deep=subtasks,comments -p OTHER`,
+	Args:              cobra.ExactArgs(1),
+	SilenceUsage:      true,
+	ValidArgsFunction: completeFirstArg(completeIssueKeys),
 	PreRun: func(cmd *cobra.Command, args []string) {
 		cloneLinkSet = cmd.Flags().Changed("link")
 	},
@@ -96,6 +160,16 @@ func init() {
 	issueCloneCmd.Flags().StringVarP(&cloneType, "type", "t", "", "Override issue type")
 	issueCloneCmd.Flags().StringSliceVarP(&cloneLabels, "labels", "L", nil, "Override labels (comma-separated)")
 	issueCloneCmd.Flags().StringVar(&cloneLink, "link", "", "Link to original issue (default: Clones, or specify type)")
+	issueCloneCmd.Flags().StringVar(&cloneEstimate, "estimate", "", "Set original estimate (Jira duration syntax)")
+	issueCloneCmd.Flags().StringVar(&cloneRemaining, "remaining", "", "Set remaining estimate (Jira duration syntax)")
+	issueCloneCmd.Flags().StringVar(&cloneDue, "due", "", "Set due date (YYYY-MM-DD, today, tomorrow, +3d, +2w, next-friday)")
+	issueCloneCmd.Flags().StringSliceVar(&cloneDeep, "deep", nil, "Also copy subtasks, attachments, comments, links, fields (default: all)")
+	issueCloneCmd.Flags().Lookup("deep").NoOptDefVal = "all"
+
+	_ = issueCloneCmd.RegisterFlagCompletionFunc("assignee", completeUsers)
+	_ = issueCloneCmd.RegisterFlagCompletionFunc("reporter", completeUsers)
+	_ = issueCloneCmd.RegisterFlagCompletionFunc("priority", completePriorities)
+	_ = issueCloneCmd.RegisterFlagCompletionFunc("type", completeIssueTypes)
 
 	issueCmd.AddCommand(issueCloneCmd)
 }
@@ -109,6 +183,11 @@ func runIssueClone(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	deep, err := parseCloneDeep(cloneDeep)
+	if err != nil {
+		return err
+	}
+
 	client := api.NewClient(cfg)
 
 	// Fetch source issue
@@ -170,6 +249,19 @@ func runIssueClone(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to build clone request: %w", err)
 	}
 
+	var warnings []string
+	if deep.CustomFields {
+		custom, skipped, err := cloneCustomFields(ctx, client, source, targetProject, issueType)
+		if err != nil {
+			if apiErr, ok := err.(*api.APIError); ok {
+				return fmt.Errorf("API error: %w", apiErr)
+			}
+			return fmt.Errorf("failed to map custom fields: %w", err)
+		}
+		req.Fields.Custom = custom
+		warnings = append(warnings, skipped...)
+	}
+
 	// Create the cloned issue
 	result, err := createClonedIssue(ctx, client, req)
 	if err != nil {
@@ -190,16 +282,26 @@ func runIssueClone(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	output := CloneResult{
+		OriginalKey:  sourceKey,
+		ClonedKey:    result.Key,
+		ClonedID:     result.ID,
+		Linked:       linked,
+		CustomFields: len(req.Fields.Custom),
+		Warnings:     warnings,
+	}
+	if linked {
+		output.LinkType = linkType
+	}
+
+	// Deep copy failures are non-fatal: the clone exists, so report what
+	// could not be copied.
+	copyCloneContent(ctx, client, source, result.Key, targetProject, deep, &output)
+	for _, w := range output.Warnings {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: %s\n", w)
+	}
+
 	if JSONOutput() {
-		output := CloneResult{
-			OriginalKey: sourceKey,
-			ClonedKey:   result.Key,
-			ClonedID:    result.ID,
-			Linked:      linked,
-		}
-		if linked {
-			output.LinkType = linkType
-		}
 		jsonOutput, err := json.MarshalIndent(output, "", "  ")
 		if err != nil {
 			return fmt.Errorf("failed to format JSON: %w", err)
@@ -213,7 +315,7 @@ func runIssueClone(cmd *cobra.Command, args []string) error {
 }
 
 func getSourceIssue(ctx context.Context, client *api.Client, key string) (*cloneSourceResponse, error) {
-	path := fmt.Sprintf("/issue/%s", key)
+	path := fmt.Sprintf("/issue/%s?expand=names", key)
 
 	body, err := client.Get(ctx, path)
 	if err != nil {
@@ -266,6 +368,22 @@ func buildCloneRequest(ctx context.Context, client *api.Client, cfg *config.Conf
 		req.Fields.Labels = labels
 	}
 
+	// Due date and estimates are only set when asked for, since the target
+	// create screen may not have them
+	if cloneDue != "" {
+		due, err := parseDueDate(cloneDue, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		req.Fields.DueDate = due
+	}
+
+	timeTracking, err := timeTrackingUpdate(ctx, client, cloneEstimate, cloneRemaining)
+	if err != nil {
+		return nil, err
+	}
+	req.Fields.TimeTracking = timeTracking
+
 	// Handle assignee
 	assigneeID, err := resolveCloneUser(ctx, client, cfg, cloneAssignee, source.Fields.Assignee)
 	if err != nil {
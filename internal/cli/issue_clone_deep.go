@@ -0,0 +1,468 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"sort"
+	"strings"
+
+	"github.com/grantcarthew/ajira/internal/api"
+	"github.com/grantcarthew/ajira/internal/converter"
+	"github.com/grantcarthew/ajira/internal/jira"
+)
+
+// cloneDeepOptions selects what --deep copies besides the core fields.
+type cloneDeepOptions struct {
+	Subtasks     bool
+	Attachments  bool
+	Comments     bool
+	Links        bool
+	CustomFields bool
+}
+
+// createMetaFieldsResponse matches the paginated create metadata fields API
+// response for a project and issue type.
+type createMetaFieldsResponse struct {
+	StartAt    int               `json:"startAt"`
+	MaxResults int               `json:"maxResults"`
+	Total      int               `json:"total"`
+	Fields     []createMetaField `json:"fields"`
+}
+
+// createMetaField is a field on the create screen. AllowedValues is set for
+// select fields and holds the target project's options.
+type createMetaField struct {
+	FieldID string `json:"fieldId"`
+	Name    string `json:"name"`
+	Schema  struct {
+		Type   string `json:"type"`
+		Items  string `json:"items"`
+		Custom string `json:"custom"`
+	} `json:"schema"`
+	AllowedValues []createMetaValue `json:"allowedValues"`
+}
+
+// createMetaValue is an allowed option. Children holds the second level of a
+// cascading select.
+type createMetaValue struct {
+	ID       string            `json:"id"`
+	Value    string            `json:"value"`
+	Name     string            `json:"name"`
+	Children []createMetaValue `json:"children"`
+}
+
+// sprintFieldType is the schema type of the Sprint custom field. Sprints
+// belong to a board, so they are not cloned.
+const sprintFieldType = "com.pyxis.greenhopper.jira:gh-sprint"
+
+// parseCloneDeep parses the --deep parts. "all" selects everything.
+func parseCloneDeep(parts []string) (cloneDeepOptions, error) {
+	var opts cloneDeepOptions
+	for _, part := range parts {
+		switch strings.ToLower(strings.TrimSpace(part)) {
+		case "all":
+			opts = cloneDeepOptions{Subtasks: true, Attachments: true, Comments: true, Links: true, CustomFields: true}
+		case "subtasks":
+			opts.Subtasks = true
+		case "attachments":
+			opts.Attachments = true
+		case "comments":
+			opts.Comments = true
+		case "links":
+			opts.Links = true
+		case "fields", "custom-fields":
+			opts.CustomFields = true
+		default:
+			return opts, fmt.Errorf("invalid --deep value: %s (use subtasks, attachments, comments, links, fields, or all)", part)
+		}
+	}
+	return opts, nil
+}
+
+// cloneCustomFields maps the source issue's custom field values onto the
+// target project's create screen. The returned warnings name fields that
+// could not be copied.
+func cloneCustomFields(ctx context.Context, client *api.Client, source *cloneSourceResponse, targetProject, issueType string) (map[string]json.RawMessage, []string, error) {
+	meta, err := fetchCreateMetaFields(ctx, client, targetProject, issueType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	custom, skipped := mapCloneCustomFields(source.Fields.Raw, source.Names, meta)
+	return custom, skipped, nil
+}
+
+func fetchCreateMetaFields(ctx context.Context, client *api.Client, projectKey, issueType string) ([]createMetaField, error) {
+	types, err := jira.GetIssueTypes(ctx, client, projectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	typeID := ""
+	for _, t := range types {
+		if strings.EqualFold(t.Name, issueType) {
+			typeID = t.ID
+			break
+		}
+	}
+	if typeID == "" {
+		return nil, fmt.Errorf("issue type %s not found in project %s", issueType, projectKey)
+	}
+
+	var fields []createMetaField
+	startAt := 0
+	for {
+		path := fmt.Sprintf("/issue/createmeta/%s/issuetypes/%s?startAt=%d&maxResults=100", projectKey, typeID, startAt)
+		body, err := client.Get(ctx, path)
+		if err != nil {
+			return nil, err
+		}
+
+		var resp createMetaFieldsResponse
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return nil, fmt.Errorf("failed to parse response: %w", err)
+		}
+
+		fields = append(fields, resp.Fields...)
+		startAt += len(resp.Fields)
+		if len(resp.Fields) == 0 || startAt >= resp.Total {
+			break
+		}
+	}
+
+	return fields, nil
+}
+
+// mapCloneCustomFields returns the custom field values to set on the clone.
+// Select options are matched by value, so option IDs from another project
+// are translated; fields not on the create screen or with no matching option
+// are skipped.
+func mapCloneCustomFields(raw map[string]json.RawMessage, names map[string]string, meta []createMetaField) (map[string]json.RawMessage, []string) {
+	byID := make(map[string]createMetaField, len(meta))
+	for _, f := range meta {
+		byID[f.FieldID] = f
+	}
+
+	var ids []string
+	for id, value := range raw {
+		v := strings.TrimSpace(string(value))
+		if strings.HasPrefix(id, "customfield_") && v != "null" && v != "[]" && v != `""` {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	custom := make(map[string]json.RawMessage)
+	var skipped []string
+	for _, id := range ids {
+		label := id
+		if names[id] != "" {
+			label = fmt.Sprintf("%s (%s)", names[id], id)
+		}
+
+		f, ok := byID[id]
+		switch {
+		case !ok:
+			skipped = append(skipped, fmt.Sprintf("custom field %s not copied: not on the target create screen", label))
+			continue
+		case f.Schema.Custom == sprintFieldType:
+			skipped = append(skipped, fmt.Sprintf("custom field %s not copied: sprints are not cloned", label))
+			continue
+		}
+
+		value, err := mapCloneFieldValue(raw[id], f)
+		if err != nil {
+			skipped = append(skipped, fmt.Sprintf("custom field %s not copied: %v", label, err))
+			continue
+		}
+		custom[id] = value
+	}
+
+	return custom, skipped
+}
+
+func mapCloneFieldValue(raw json.RawMessage, f createMetaField) (json.RawMessage, error) {
+	switch {
+	case len(f.AllowedValues) > 0 && f.Schema.Type == "array":
+		var items []json.RawMessage
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return nil, fmt.Errorf("unexpected value: %w", err)
+		}
+		mapped := make([]json.RawMessage, 0, len(items))
+		for _, item := range items {
+			value, err := mapCloneOption(item, f.AllowedValues)
+			if err != nil {
+				return nil, err
+			}
+			mapped = append(mapped, value)
+		}
+		return json.Marshal(mapped)
+	case len(f.AllowedValues) > 0:
+		return mapCloneOption(raw, f.AllowedValues)
+	case f.Schema.Type == "user" || f.Schema.Items == "user":
+		return mapCloneUsers(raw)
+	default:
+		return raw, nil
+	}
+}
+
+// mapCloneOption translates a select option, including a cascading child,
+// to the matching option ID in the target.
+func mapCloneOption(raw json.RawMessage, allowed []createMetaValue) (json.RawMessage, error) {
+	var opt struct {
+		Value string `json:"value"`
+		Name  string `json:"name"`
+		Child *struct {
+			Value string `json:"value"`
+		} `json:"child"`
+	}
+	if err := json.Unmarshal(raw, &opt); err != nil {
+		return nil, fmt.Errorf("unexpected value: %w", err)
+	}
+
+	label := opt.Value
+	if label == "" {
+		label = opt.Name
+	}
+	match := findCreateMetaValue(allowed, label)
+	if match == nil {
+		return nil, fmt.Errorf("no option %q in the target project", label)
+	}
+
+	mapped := map[string]any{"id": match.ID}
+	if opt.Child != nil {
+		child := findCreateMetaValue(match.Children, opt.Child.Value)
+		if child == nil {
+			return nil, fmt.Errorf("no option %q under %q in the target project", opt.Child.Value, label)
+		}
+		mapped["child"] = map[string]string{"id": child.ID}
+	}
+	return json.Marshal(mapped)
+}
+
+func findCreateMetaValue(values []createMetaValue, label string) *createMetaValue {
+	for i, v := range values {
+		if strings.EqualFold(v.Value, label) || strings.EqualFold(v.Name, label) {
+			return &values[i]
+		}
+	}
+	return nil
+}
+
+// mapCloneUsers reduces a user or user list value to account IDs.
+func mapCloneUsers(raw json.RawMessage) (json.RawMessage, error) {
+	var users []userField
+	if err := json.Unmarshal(raw, &users); err == nil {
+		ids := make([]accountID, len(users))
+		for i, u := range users {
+			ids[i] = accountID{AccountID: u.AccountID}
+		}
+		return json.Marshal(ids)
+	}
+
+	var user userField
+	if err := json.Unmarshal(raw, &user); err != nil {
+		return nil, fmt.Errorf("unexpected value: %w", err)
+	}
+	return json.Marshal(accountID{AccountID: user.AccountID})
+}
+
+// copyCloneContent copies subtasks, attachments, comments, and links from the
+// source to the clone as selected. Failures are recorded as warnings on result.
+func copyCloneContent(ctx context.Context, client *api.Client, source *cloneSourceResponse, cloneKey, targetProject string, opts cloneDeepOptions, result *CloneResult) {
+	if opts.Subtasks {
+		for _, st := range source.Fields.Subtasks {
+			key, err := cloneSubtask(ctx, client, st.Key, cloneKey, targetProject)
+			if err != nil {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("subtask %s not cloned: %v", st.Key, err))
+				continue
+			}
+			result.Subtasks = append(result.Subtasks, key)
+		}
+	}
+
+	if opts.Attachments {
+		for _, a := range source.Fields.Attachment {
+			if err := copyAttachment(ctx, client, cloneKey, a); err != nil {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("attachment %s not copied: %v", a.Filename, err))
+				continue
+			}
+			result.Attachments++
+		}
+	}
+
+	if opts.Comments {
+		comments, err := fetchAllComments(ctx, client, source.Key)
+		if err != nil {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("comments not copied: %v", err))
+		}
+		for _, c := range comments {
+			if err := copyComment(ctx, client, cloneKey, c); err != nil {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("comment %s not copied: %v", c.ID, err))
+				continue
+			}
+			result.Comments++
+		}
+	}
+
+	if opts.Links {
+		for _, link := range source.Fields.IssueLinks {
+			var other string
+			var err error
+			switch {
+			case link.OutwardIssue != nil:
+				other = link.OutwardIssue.Key
+				err = createIssueLink(ctx, client, cloneKey, other, link.Type.Name)
+			case link.InwardIssue != nil:
+				other = link.InwardIssue.Key
+				err = createIssueLink(ctx, client, other, cloneKey, link.Type.Name)
+			default:
+				continue
+			}
+			if err != nil {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("%s link to %s not copied: %v", link.Type.Name, other, err))
+				continue
+			}
+			result.Links++
+		}
+	}
+}
+
+// cloneSubtask recreates a subtask with its core fields under parentKey.
+func cloneSubtask(ctx context.Context, client *api.Client, key, parentKey, targetProject string) (string, error) {
+	sub, err := getSourceIssue(ctx, client, key)
+	if err != nil {
+		return "", err
+	}
+	if sub.Fields.IssueType == nil {
+		return "", fmt.Errorf("could not determine issue type")
+	}
+
+	fields := cloneCreateFields{
+		Project:     projectKey{Key: targetProject},
+		Summary:     sub.Fields.Summary,
+		Description: sub.Fields.Description,
+		IssueType:   issueTypeName{Name: sub.Fields.IssueType.Name},
+		Labels:      sub.Fields.Labels,
+		Parent:      &issueRef{Key: parentKey},
+	}
+	if sub.Fields.Priority != nil {
+		fields.Priority = &priorityName{Name: sub.Fields.Priority.Name}
+	}
+	if sub.Fields.Assignee != nil && sub.Fields.Assignee.AccountID != "" {
+		fields.Assignee = &accountID{AccountID: sub.Fields.Assignee.AccountID}
+	}
+
+	result, err := createClonedIssue(ctx, client, &cloneCreateRequest{Fields: fields})
+	if err != nil {
+		return "", err
+	}
+	return result.Key, nil
+}
+
+// copyAttachment streams an attachment from the source straight into an
+// upload on issueKey, without buffering the file.
+func copyAttachment(ctx context.Context, client *api.Client, issueKey string, a attachmentValue) error {
+	totalSize, boundary, err := calcMultipartSize([]string{a.Filename}, []int64{a.Size})
+	if err != nil {
+		return fmt.Errorf("failed to calculate upload size: %w", err)
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		mw := multipart.NewWriter(pw)
+		if err := mw.SetBoundary(boundary); err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+		part, err := mw.CreateFormFile("file", a.Filename)
+		if err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+		if err := client.DownloadToWriter(ctx, fmt.Sprintf("/attachment/content/%s", a.ID), part); err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+		if err := mw.Close(); err != nil {
+			pw.CloseWithError(err)
+			return
+		}
+		pw.Close()
+	}()
+
+	contentType := "multipart/form-data; boundary=" + boundary
+	path := fmt.Sprintf("/issue/%s/attachments", issueKey)
+	if _, err := client.PostMultipart(ctx, path, contentType, pr, totalSize); err != nil {
+		pr.CloseWithError(err) // unblock goroutine if still writing
+		return err
+	}
+	return nil
+}
+
+// fetchAllComments returns every comment on an issue, oldest first.
+func fetchAllComments(ctx context.Context, client *api.Client, key string) ([]commentValue, error) {
+	var comments []commentValue
+	for {
+		path := fmt.Sprintf("/issue/%s/comment?startAt=%d&maxResults=100&orderBy=created&expand=properties", key, len(comments))
+		body, err := client.Get(ctx, path)
+		if err != nil {
+			return nil, err
+		}
+
+		var resp commentsResponse
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return nil, fmt.Errorf("failed to parse response: %w", err)
+		}
+
+		comments = append(comments, resp.Comments...)
+		if len(resp.Comments) == 0 || len(comments) >= resp.Total {
+			return comments, nil
+		}
+	}
+}
+
+// copyComment posts a comment on issueKey with a line naming the original
+// author and date. Visibility and the service desk internal flag carry over.
+func copyComment(ctx context.Context, client *api.Client, issueKey string, c commentValue) error {
+	var doc converter.ADF
+	if len(c.Body) > 0 {
+		if err := json.Unmarshal(c.Body, &doc); err != nil {
+			return fmt.Errorf("failed to parse comment body: %w", err)
+		}
+	}
+
+	author := "unknown"
+	if c.Author != nil && c.Author.DisplayName != "" {
+		author = c.Author.DisplayName
+	}
+	attribution := converter.ADFNode{
+		Type: converter.NodeTypeParagraph,
+		Content: []converter.ADFNode{{
+			Type:  converter.NodeTypeText,
+			Text:  fmt.Sprintf("Originally by %s on %s", author, formatDateTime(c.Created)),
+			Marks: []converter.ADFMark{{Type: converter.MarkTypeEm}},
+		}},
+	}
+	doc.Version = converter.ADFVersion
+	doc.Type = converter.NodeTypeDoc
+	doc.Content = append([]converter.ADFNode{attribution}, doc.Content...)
+
+	req := commentAddRequest{Body: &doc, Visibility: c.Visibility}
+	for _, p := range c.Properties {
+		if p.Key == internalCommentProperty {
+			req.Properties = append(req.Properties, p)
+		}
+	}
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	_, err = client.Post(ctx, fmt.Sprintf("/issue/%s/comment", issueKey), body)
+	return err
+}
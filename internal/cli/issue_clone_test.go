@@ -7,6 +7,7 @@ package cli
 import (
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -27,6 +28,10 @@ func resetCloneFlags() {
 	cloneLabels = nil
 	cloneLink = ""
 	cloneLinkSet = false
+	cloneEstimate = ""
+	cloneRemaining = ""
+	cloneDue = ""
+	cloneDeep = nil
 }
 
 // Test getSourceIssue function
@@ -377,3 +382,280 @@ func TestBuildCloneRequest_NoOverrides(t *testing.T) {
 		t.Errorf("expected reporter 'user456', got %s", req.Fields.Reporter.AccountID)
 	}
 }
+
+func TestBuildCloneRequest_CrossProjectWithoutTimeFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte(`{"key": "PROJ-1", "fields": {"summary": "Original", "issuetype": {"name": "Task"},
+				"project": {"key": "PROJ"}, "duedate": "2026-01-31", "timetracking": {"originalEstimate": "2d"}}}`))
+			return
+		}
+
+		// The OTHER create screen has neither Due Date nor Time Tracking
+		var req struct {
+			Fields map[string]json.RawMessage `json:"fields"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		for _, id := range []string{"duedate", "timetracking"} {
+			if _, ok := req.Fields[id]; ok {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"errors": {"` + id + `": "Field cannot be set. It is not on the appropriate screen, or unknown."}}`))
+				return
+			}
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"key": "OTHER-1", "id": "10001"}`))
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	cfg := testConfig(server.URL)
+	resetCloneFlags()
+
+	source, err := getSourceIssue(context.Background(), client, "PROJ-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	req, err := buildCloneRequest(context.Background(), client, cfg, source, "OTHER", "Task")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	result, err := createClonedIssue(context.Background(), client, req)
+	if err != nil {
+		t.Fatalf("expected clone without due date or estimate, got %v", err)
+	}
+	if result.Key != "OTHER-1" {
+		t.Errorf("expected key OTHER-1, got %s", result.Key)
+	}
+}
+
+func TestParseCloneDeep(t *testing.T) {
+	opts, err := parseCloneDeep([]string{"all"})
+	if err != nil || !opts.Subtasks || !opts.Attachments || !opts.Comments || !opts.Links || !opts.CustomFields {
+		t.Errorf("expected everything for all, got %+v, %v", opts, err)
+	}
+
+	opts, err = parseCloneDeep([]string{"subtasks", " Comments", "fields"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !opts.Subtasks || !opts.Comments || !opts.CustomFields || opts.Attachments || opts.Links {
+		t.Errorf("unexpected options: %+v", opts)
+	}
+
+	if _, err := parseCloneDeep([]string{"watchers"}); err == nil {
+		t.Error("expected error for unknown part")
+	}
+}
+
+func TestMapCloneCustomFields_CrossProject(t *testing.T) {
+	raw := map[string]json.RawMessage{
+		"summary":           json.RawMessage(`"Onboarding"`),
+		"customfield_10010": json.RawMessage(`{"id": "100", "value": "High"}`),
+		"customfield_10011": json.RawMessage(`[{"id": "200", "value": "VPN"}, {"id": "201", "value": "Email"}]`),
+		"customfield_10012": json.RawMessage(`{"id": "300", "value": "EMEA", "child": {"id": "301", "value": "London"}}`),
+		"customfield_10013": json.RawMessage(`{"accountId": "abc", "displayName": "Jane"}`),
+		"customfield_10014": json.RawMessage(`"Team notes"`),
+		"customfield_10015": json.RawMessage(`{"id": "400", "value": "Legacy"}`),
+		"customfield_10016": json.RawMessage(`"0|i0000:"`),
+		"customfield_10017": json.RawMessage(`null`),
+		"customfield_10020": json.RawMessage(`[{"id": 5, "name": "Sprint 5"}]`),
+	}
+	names := map[string]string{"customfield_10015": "Tier", "customfield_10016": "Rank"}
+
+	var meta []createMetaField
+	if err := json.Unmarshal([]byte(`[
+		{"fieldId": "customfield_10010", "name": "Severity", "schema": {"type": "option"}, "allowedValues": [{"id": "900", "value": "high"}]},
+		{"fieldId": "customfield_10011", "name": "Systems", "schema": {"type": "array", "items": "option"}, "allowedValues": [{"id": "910", "value": "Email"}, {"id": "911", "value": "VPN"}]},
+		{"fieldId": "customfield_10012", "name": "Region", "schema": {"type": "option-with-child"}, "allowedValues": [{"id": "920", "value": "EMEA", "children": [{"id": "921", "value": "London"}]}]},
+		{"fieldId": "customfield_10013", "name": "Buddy", "schema": {"type": "user"}},
+		{"fieldId": "customfield_10014", "name": "Notes", "schema": {"type": "string"}},
+		{"fieldId": "customfield_10015", "name": "Tier", "schema": {"type": "option"}, "allowedValues": [{"id": "930", "value": "Gold"}]},
+		{"fieldId": "customfield_10020", "name": "Sprint", "schema": {"type": "array", "custom": "com.pyxis.greenhopper.jira:gh-sprint"}}
+	]`), &meta); err != nil {
+		t.Fatalf("bad fixture: %v", err)
+	}
+
+	custom, skipped := mapCloneCustomFields(raw, names, meta)
+
+	want := map[string]string{
+		"customfield_10010": `{"id":"900"}`,
+		"customfield_10011": `[{"id":"911"},{"id":"910"}]`,
+		"customfield_10012": `{"child":{"id":"921"},"id":"920"}`,
+		"customfield_10013": `{"accountId":"abc"}`,
+		"customfield_10014": `"Team notes"`,
+	}
+	if len(custom) != len(want) {
+		t.Errorf("expected %d fields, got %d: %v", len(want), len(custom), custom)
+	}
+	for id, w := range want {
+		if string(custom[id]) != w {
+			t.Errorf("%s: expected %s, got %s", id, w, custom[id])
+		}
+	}
+
+	if len(skipped) != 3 {
+		t.Fatalf("expected 3 skipped fields, got %v", skipped)
+	}
+	if !strings.Contains(skipped[0], `Tier (customfield_10015)`) || !strings.Contains(skipped[0], `no option "Legacy"`) {
+		t.Errorf("unexpected warning: %s", skipped[0])
+	}
+	if !strings.Contains(skipped[1], "Rank (customfield_10016)") || !strings.Contains(skipped[1], "create screen") {
+		t.Errorf("unexpected warning: %s", skipped[1])
+	}
+	if !strings.Contains(skipped[2], "sprints") {
+		t.Errorf("unexpected warning: %s", skipped[2])
+	}
+}
+
+func TestCloneCreateFields_MarshalCustom(t *testing.T) {
+	fields := cloneCreateFields{
+		Project:   projectKey{Key: "HR"},
+		Summary:   "Onboard",
+		IssueType: issueTypeName{Name: "Sub-task"},
+		Parent:    &issueRef{Key: "HR-1"},
+		Custom:    map[string]json.RawMessage{"customfield_10010": json.RawMessage(`{"id":"900"}`)},
+	}
+
+	data, err := json.Marshal(cloneCreateRequest{Fields: fields})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := string(data)
+	for _, want := range []string{`"customfield_10010":{"id":"900"}`, `"parent":{"key":"HR-1"}`, `"summary":"Onboard"`} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected %s in %s", want, got)
+		}
+	}
+	if strings.Contains(got, "Custom") {
+		t.Errorf("Custom map should not be serialised by name: %s", got)
+	}
+}
+
+func TestCopyAttachment_Streams(t *testing.T) {
+	content := "welcome pack contents"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/3/attachment/content/55":
+			_, _ = w.Write([]byte(content))
+		case "/rest/api/3/issue/HR-2/attachments":
+			if r.ContentLength <= int64(len(content)) {
+				t.Errorf("expected exact multipart Content-Length, got %d", r.ContentLength)
+			}
+			file, header, err := r.FormFile("file")
+			if err != nil {
+				t.Fatalf("failed to read upload: %v", err)
+			}
+			data, _ := io.ReadAll(file)
+			if header.Filename != "welcome.pdf" || string(data) != content {
+				t.Errorf("unexpected upload %s: %q", header.Filename, data)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`[{"id": "56", "filename": "welcome.pdf"}]`))
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	a := attachmentValue{ID: "55", Filename: "welcome.pdf", Size: int64(len(content))}
+	if err := copyAttachment(context.Background(), client, "HR-2", a); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestCopyCloneContent(t *testing.T) {
+	var created []cloneCreateRequest
+	var comments []map[string]any
+	var links []issueLinkRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/rest/api/3/issue/HR-11":
+			_, _ = w.Write([]byte(`{"key": "HR-11", "fields": {"summary": "Laptop", "issuetype": {"name": "Sub-task"}, "assignee": {"accountId": "it1"},
+				"duedate": "2026-01-31", "timetracking": {"originalEstimate": "1d"}}}`))
+		case r.URL.Path == "/rest/api/3/issue" && r.Method == http.MethodPost:
+			var req cloneCreateRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			created = append(created, req)
+			_, _ = w.Write([]byte(`{"id": "20021", "key": "HR-21"}`))
+		case r.URL.Path == "/rest/api/3/issue/HR-10/comment":
+			if r.URL.Query().Get("startAt") == "0" {
+				_, _ = w.Write([]byte(`{"total": 2, "comments": [{"id": "1", "author": {"displayName": "Sam"}, "created": "2026-01-05T09:00:00.000+0000",
+					"body": {"type": "doc", "version": 1, "content": [{"type": "paragraph", "content": [{"type": "text", "text": "Day one"}]}]}}]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"total": 2, "comments": [{"id": "2", "author": {"displayName": "Ana"}, "created": "2026-01-06T09:00:00.000+0000",
+				"body": {"type": "doc", "version": 1, "content": []}, "properties": [{"key": "sd.public.comment", "value": {"internal": true}}, {"key": "other", "value": 1}]}]}`))
+		case r.URL.Path == "/rest/api/3/issue/HR-20/comment":
+			var req map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			comments = append(comments, req)
+			_, _ = w.Write([]byte(`{"id": "9"}`))
+		case r.URL.Path == "/rest/api/3/issueLink":
+			var req issueLinkRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			links = append(links, req)
+			w.WriteHeader(http.StatusCreated)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	var source cloneSourceResponse
+	if err := json.Unmarshal([]byte(`{"key": "HR-10", "fields": {
+		"subtasks": [{"key": "HR-11"}],
+		"issuelinks": [
+			{"type": {"name": "Blocks"}, "outwardIssue": {"key": "IT-5"}},
+			{"type": {"name": "Relates"}, "inwardIssue": {"key": "HR-3"}}
+		]}}`), &source); err != nil {
+		t.Fatalf("bad fixture: %v", err)
+	}
+
+	client := api.NewClient(testConfig(server.URL))
+	var result CloneResult
+	opts := cloneDeepOptions{Subtasks: true, Comments: true, Links: true}
+	copyCloneContent(context.Background(), client, &source, "HR-20", "HR", opts, &result)
+
+	if len(result.Warnings) != 0 {
+		t.Errorf("unexpected warnings: %v", result.Warnings)
+	}
+
+	if len(result.Subtasks) != 1 || result.Subtasks[0] != "HR-21" {
+		t.Errorf("expected subtask HR-21, got %v", result.Subtasks)
+	}
+	if len(created) != 1 || created[0].Fields.Parent == nil || created[0].Fields.Parent.Key != "HR-20" ||
+		created[0].Fields.Assignee == nil || created[0].Fields.Assignee.AccountID != "it1" {
+		t.Errorf("unexpected subtask request: %+v", created)
+	}
+	if len(created) == 1 && (created[0].Fields.DueDate != "" || created[0].Fields.TimeTracking != nil) {
+		t.Errorf("expected no due date or estimate on the subtask, got %+v", created[0].Fields)
+	}
+
+	if result.Comments != 2 || len(comments) != 2 {
+		t.Fatalf("expected 2 comments copied, got %d", result.Comments)
+	}
+	first, _ := json.Marshal(comments[0]["body"])
+	if !strings.Contains(string(first), "Originally by Sam on") || !strings.Contains(string(first), "Day one") {
+		t.Errorf("expected attribution and original text, got %s", first)
+	}
+	props, _ := json.Marshal(comments[1]["properties"])
+	if string(props) != `[{"key":"sd.public.comment","value":{"internal":true}}]` {
+		t.Errorf("expected only the internal flag to carry over, got %s", props)
+	}
+
+	// createIssueLink swaps keys into Jira's inward/outward request fields.
+	if result.Links != 2 || len(links) != 2 {
+		t.Fatalf("expected 2 links copied, got %d", result.Links)
+	}
+	if links[0].InwardIssue.Key != "HR-20" || links[0].OutwardIssue.Key != "IT-5" {
+		t.Errorf("expected HR-20 blocks IT-5, got %+v", links[0])
+	}
+	if links[1].InwardIssue.Key != "HR-3" || links[1].OutwardIssue.Key != "HR-20" {
+		t.Errorf("expected HR-3 relates to HR-20, got %+v", links[1])
+	}
+}
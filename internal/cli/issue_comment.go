@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/grantcarthew/ajira/internal/api"
 	"github.com/grantcarthew/ajira/internal/config"
@@ -20,13 +21,33 @@ type CommentResult struct {
 
 // commentAddRequest represents the request body for adding a comment.
 type commentAddRequest struct {
-	Body *converter.ADF `json:"body"`
+	Body       *converter.ADF     `json:"body"`
+	Visibility *commentVisibility `json:"visibility,omitempty"`
+	Properties []commentProperty  `json:"properties,omitempty"`
 }
 
+// commentVisibility restricts a comment to a project role or group.
+type commentVisibility struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// commentProperty is a comment entity property. Jira Service Management
+// marks internal comments with sd.public.comment set to {"internal": true}.
+type commentProperty struct {
+	Key   string          `json:"key"`
+	Value json.RawMessage `json:"value"`
+}
+
+// internalCommentProperty is the property key for service desk internal
+// comments.
+const internalCommentProperty = "sd.public.comment"
+
 var (
-	commentBody  string
-	commentFile  string
-	commentStdin bool
+	commentBody      string
+	commentFile      string
+	commentStdin     bool
+	commentVisibleTo string
 )
 
 var issueCommentCmd = &cobra.Command{
@@ -41,19 +62,22 @@ var issueCommentCmd = &cobra.Command{
 var issueCommentEditCmd = &cobra.Command{
 	Use:   "edit <issue-key> <comment-id> [text]",
 	Short: "Edit comment",
-	Long:  "Edit an existing comment. Use 'issue view -c N' to find comment IDs.",
+	Long: `Edit an existing comment. Use 'issue view -c N' to find comment IDs.
+The comment keeps its visibility restriction and internal flag unless --visibility is given.`,
 	Example: `  ajira issue comment edit PROJ-123 12345 "Updated text"   # Inline
   ajira issue comment edit PROJ-123 12345 -b "New text"    # Via --body
   ajira issue comment edit PROJ-123 12345 -f comment.md    # From file
-  echo "text" | ajira issue comment edit PROJ-123 12345 -f - # From stdin`,
+  echo "text" | ajira issue comment edit PROJ-123 12345 -f - # From stdin
+  ajira issue comment edit PROJ-123 12345 "Text" --visibility role:Developers`,
 	Args: func(cmd *cobra.Command, args []string) error {
 		if len(args) < 2 || len(args) > 3 {
 			return fmt.Errorf("requires 2 or 3 arguments: <issue-key> <comment-id> [text]")
 		}
 		return nil
 	},
-	SilenceUsage: true,
-	RunE:         runIssueCommentEdit,
+	SilenceUsage:      true,
+	ValidArgsFunction: completeFirstArg(completeIssueKeys),
+	RunE:              runIssueCommentEdit,
 }
 
 var issueCommentAddCmd = &cobra.Command{
@@ -63,7 +87,9 @@ var issueCommentAddCmd = &cobra.Command{
 	Example: `  ajira issue comment add PROJ-123 "Comment text"   # Inline comment
   ajira issue comment add PROJ-123 -f comment.md    # From file
   echo "text" | ajira issue comment add PROJ-123 -f - # From stdin
-  echo -e "PROJ-1\nPROJ-2" | ajira issue comment add --stdin "Comment for all"  # Batch`,
+  echo -e "PROJ-1\nPROJ-2" | ajira issue comment add --stdin "Comment for all"  # Batch
+  ajira issue comment add PROJ-123 "Internal note" --visibility role:Developers
+  ajira issue comment add PROJ-123 "Staff only" --visibility group:jira-staff`,
 	Args: func(cmd *cobra.Command, args []string) error {
 		if commentStdin {
 			// With --stdin, comment text must be provided via arg or --body (not --file -)
@@ -81,17 +107,20 @@ var issueCommentAddCmd = &cobra.Command{
 		}
 		return nil
 	},
-	SilenceUsage: true,
-	RunE:         runIssueCommentAdd,
+	SilenceUsage:      true,
+	ValidArgsFunction: completeFirstArg(completeIssueKeys),
+	RunE:              runIssueCommentAdd,
 }
 
 func init() {
 	issueCommentAddCmd.Flags().StringVarP(&commentBody, "body", "b", "", "Comment text in Markdown")
 	issueCommentAddCmd.Flags().StringVarP(&commentFile, "file", "f", "", "Read comment from file (use - for stdin)")
 	issueCommentAddCmd.Flags().BoolVar(&commentStdin, "stdin", false, "Read issue keys from stdin (one per line)")
+	issueCommentAddCmd.Flags().StringVar(&commentVisibleTo, "visibility", "", "Restrict to a project role or group (role:<name> or group:<name>)")
 
 	issueCommentEditCmd.Flags().StringVarP(&commentBody, "body", "b", "", "Comment text in Markdown")
 	issueCommentEditCmd.Flags().StringVarP(&commentFile, "file", "f", "", "Read comment from file (use - for stdin)")
+	issueCommentEditCmd.Flags().StringVar(&commentVisibleTo, "visibility", "", "Restrict to a project role or group (role:<name> or group:<name>)")
 
 	issueCommentCmd.AddCommand(issueCommentAddCmd)
 	issueCommentCmd.AddCommand(issueCommentEditCmd)
@@ -117,6 +146,9 @@ func runIssueCommentAdd(cmd *cobra.Command, args []string) error {
 		if err != nil {
 			return err
 		}
+		if err := checkBatchPolicy(len(issueKeys)); err != nil {
+			return err
+		}
 		if len(issueKeys) == 0 {
 			return fmt.Errorf("no issue keys provided via stdin")
 		}
@@ -136,6 +168,11 @@ func runIssueCommentAdd(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("comment text is required (provide as argument, --body, or --file)")
 	}
 
+	visibility, err := parseCommentVisibility(commentVisibleTo)
+	if err != nil {
+		return err
+	}
+
 	// Dry-run mode
 	if DryRun() {
 		preview := commentText
@@ -152,7 +189,7 @@ func runIssueCommentAdd(cmd *cobra.Command, args []string) error {
 
 	// Single comment
 	if len(issueKeys) == 1 {
-		result, err := addComment(ctx, client, issueKeys[0], commentText)
+		result, err := addComment(ctx, client, issueKeys[0], commentText, visibility)
 		if err != nil {
 			return err
 		}
@@ -168,9 +205,9 @@ func runIssueCommentAdd(cmd *cobra.Command, args []string) error {
 	// Batch comments
 	var results []BatchResult
 	for _, key := range issueKeys {
-		_, err := addComment(ctx, client, key, commentText)
+		_, err := addComment(ctx, client, key, commentText, visibility)
 		if err != nil {
-			results = append(results, BatchResult{Key: key, Success: false, Error: err.Error()})
+			results = append(results, failedResult(key, err))
 		} else {
 			results = append(results, BatchResult{Key: key, Success: true})
 		}
@@ -205,13 +242,28 @@ func getCommentTextForBatch(args []string) (string, error) {
 	return "", nil
 }
 
-func addComment(ctx context.Context, client *api.Client, issueKey, text string) (*CommentResult, error) {
+// parseCommentVisibility parses a --visibility value such as
+// role:Developers or group:jira-staff. Returns nil when empty.
+func parseCommentVisibility(value string) (*commentVisibility, error) {
+	if value == "" {
+		return nil, nil
+	}
+	kind, name, ok := strings.Cut(value, ":")
+	kind = strings.ToLower(strings.TrimSpace(kind))
+	name = strings.TrimSpace(name)
+	if !ok || name == "" || (kind != "role" && kind != "group") {
+		return nil, fmt.Errorf("invalid visibility: %s (use role:<name> or group:<name>)", value)
+	}
+	return &commentVisibility{Type: kind, Value: name}, nil
+}
+
+func addComment(ctx context.Context, client *api.Client, issueKey, text string, visibility *commentVisibility) (*CommentResult, error) {
 	adf, err := converter.MarkdownToADF(text)
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert comment: %w", err)
 	}
 
-	req := commentAddRequest{Body: adf}
+	req := commentAddRequest{Body: adf, Visibility: visibility}
 
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -253,6 +305,11 @@ func runIssueCommentEdit(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("comment text is required (provide as argument, --body, or --file)")
 	}
 
+	visibility, err := parseCommentVisibility(commentVisibleTo)
+	if err != nil {
+		return err
+	}
+
 	if DryRun() {
 		preview := commentText
 		if len(preview) > 50 {
@@ -262,7 +319,26 @@ func runIssueCommentEdit(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	result, err := editComment(ctx, client, issueKey, commentID, commentText)
+	// Carry over the existing restriction so that edits never make a
+	// restricted or internal comment public.
+	var properties []commentProperty
+	existing, err := getComment(ctx, client, issueKey, commentID)
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); ok {
+			return fmt.Errorf("API error: %w", apiErr)
+		}
+		return fmt.Errorf("failed to fetch comment: %w", err)
+	}
+	if visibility == nil {
+		visibility = existing.Visibility
+	}
+	for _, p := range existing.Properties {
+		if p.Key == internalCommentProperty {
+			properties = append(properties, p)
+		}
+	}
+
+	result, err := editComment(ctx, client, issueKey, commentID, commentText, visibility, properties)
 	if err != nil {
 		return err
 	}
@@ -286,13 +362,27 @@ func getCommentTextForEdit(args []string) (string, error) {
 	return "", nil
 }
 
-func editComment(ctx context.Context, client *api.Client, issueKey, commentID, text string) (*CommentResult, error) {
+// getComment fetches a single comment with its properties.
+func getComment(ctx context.Context, client *api.Client, issueKey, commentID string) (*commentValue, error) {
+	body, err := client.Get(ctx, fmt.Sprintf("/issue/%s/comment/%s?expand=properties", issueKey, commentID))
+	if err != nil {
+		return nil, err
+	}
+
+	var c commentValue
+	if err := json.Unmarshal(body, &c); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	return &c, nil
+}
+
+func editComment(ctx context.Context, client *api.Client, issueKey, commentID, text string, visibility *commentVisibility, properties []commentProperty) (*CommentResult, error) {
 	adf, err := converter.MarkdownToADF(text)
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert comment: %w", err)
 	}
 
-	req := commentAddRequest{Body: adf}
+	req := commentAddRequest{Body: adf, Visibility: visibility, Properties: properties}
 
 	body, err := json.Marshal(req)
 	if err != nil {
@@ -0,0 +1,105 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/grantcarthew/ajira/internal/api"
+	"github.com/grantcarthew/ajira/internal/config"
+	"github.com/spf13/cobra"
+)
+
+var commentDeleteStdin bool
+
+var issueCommentDeleteCmd = &cobra.Command{
+	Use:   "delete <issue-key> <comment-id> [comment-id...]",
+	Short: "Delete comments",
+	Long:  "Delete one or more comments from an issue. Use --stdin to read comment IDs (one per line).",
+	Example: `  ajira issue comment delete PROJ-123 12345
+  ajira issue comment delete PROJ-123 12345 12346 --dry-run
+  ajira issue comment list PROJ-123 --all --json | jq -r '.[].id' | ajira issue comment delete PROJ-123 --stdin`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if commentDeleteStdin {
+			if len(args) != 1 {
+				return fmt.Errorf("with --stdin, requires exactly 1 argument: <issue-key>")
+			}
+		} else if len(args) < 2 {
+			return fmt.Errorf("requires at least 2 arguments: <issue-key> <comment-id>")
+		}
+		return nil
+	},
+	SilenceUsage: true,
+	RunE:         runIssueCommentDelete,
+}
+
+func init() {
+	issueCommentDeleteCmd.Flags().BoolVar(&commentDeleteStdin, "stdin", false, "Read comment IDs from stdin (one per line)")
+
+	issueCommentCmd.AddCommand(issueCommentDeleteCmd)
+}
+
+func runIssueCommentDelete(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	issueKey := args[0]
+	commentIDs := args[1:]
+
+	if commentDeleteStdin {
+		var err error
+		commentIDs, err = ReadKeysFromStdin()
+		if err != nil {
+			return err
+		}
+		if len(commentIDs) == 0 {
+			return fmt.Errorf("no comment IDs provided via stdin")
+		}
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	if DryRun() {
+		if len(commentIDs) == 1 {
+			PrintDryRun(fmt.Sprintf("delete comment %s from %s", commentIDs[0], issueKey))
+		} else {
+			PrintDryRunBatch(commentIDs, fmt.Sprintf("delete comment from %s", issueKey))
+		}
+		return nil
+	}
+
+	client := api.NewClient(cfg)
+
+	if len(commentIDs) == 1 {
+		if err := deleteComment(ctx, client, issueKey, commentIDs[0]); err != nil {
+			if apiErr, ok := err.(*api.APIError); ok {
+				return fmt.Errorf("API error: %w", apiErr)
+			}
+			return fmt.Errorf("failed to delete comment: %w", err)
+		}
+
+		if JSONOutput() {
+			PrintSuccessJSON(map[string]string{"issueKey": issueKey, "deleted": commentIDs[0]})
+		} else {
+			PrintSuccess(IssueURL(cfg.BaseURL, issueKey))
+		}
+		return nil
+	}
+
+	var results []BatchResult
+	for _, id := range commentIDs {
+		if err := deleteComment(ctx, client, issueKey, id); err != nil {
+			results = append(results, failedResult(id, err))
+		} else {
+			results = append(results, BatchResult{Key: id, Success: true})
+		}
+	}
+
+	return PrintBatchResults(results)
+}
+
+func deleteComment(ctx context.Context, client *api.Client, issueKey, commentID string) error {
+	path := fmt.Sprintf("/issue/%s/comment/%s", issueKey, commentID)
+	_, err := client.Delete(ctx, path)
+	return err
+}
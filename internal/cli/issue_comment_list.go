@@ -1,33 +1,58 @@
 package cli
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/grantcarthew/ajira/internal/api"
 	"github.com/grantcarthew/ajira/internal/config"
 	"github.com/spf13/cobra"
 )
 
+// commentListOptions controls which comments listComments returns.
+type commentListOptions struct {
+	Limit int       // Maximum comments to return; 0 for no limit
+	Since time.Time // Only comments created at or after this time
+	Order string    // asc (oldest first) or desc (newest first)
+}
+
+// commentRelativeSince matches relative --since values such as "-7d" or "2w".
+var commentRelativeSince = regexp.MustCompile(`^-?(\d+)([dw])$`)
+
 var (
 	commentListLimit int
+	commentListAll   bool
+	commentListSince string
+	commentListOrder string
 )
 
 var issueCommentListCmd = &cobra.Command{
 	Use:   "list <issue-key>",
 	Short: "List comments",
-	Long:  "List comments for an issue with ID, author, date, and body.",
-	Example: `  ajira issue comment list PROJ-123          # List 5 most recent comments
-  ajira issue comment list PROJ-123 -l 20   # List 20 most recent comments
-  ajira issue comment list PROJ-123 --json  # JSON output`,
-	Args:         cobra.ExactArgs(1),
-	SilenceUsage: true,
-	RunE:         runIssueCommentList,
+	Long: `List comments for an issue with ID, author, date, visibility, and body.
+Use --all to page through the full thread, --since to skip older comments, and --order to choose the direction.`,
+	Example: `  ajira issue comment list PROJ-123                   # List 5 most recent comments
+  ajira issue comment list PROJ-123 -l 20            # List 20 most recent comments
+  ajira issue comment list PROJ-123 --all --order asc  # Full thread, oldest first
+  ajira issue comment list PROJ-123 --all --since -7d  # Last week's comments
+  ajira issue comment list PROJ-123 --json           # JSON output`,
+	Args:              cobra.ExactArgs(1),
+	SilenceUsage:      true,
+	ValidArgsFunction: completeFirstArg(completeIssueKeys),
+	RunE:              runIssueCommentList,
 }
 
 func init() {
 	issueCommentListCmd.Flags().IntVarP(&commentListLimit, "limit", "l", 5, "Maximum number of comments to show")
+	issueCommentListCmd.Flags().BoolVar(&commentListAll, "all", false, "Show all comments (ignores --limit)")
+	issueCommentListCmd.Flags().StringVar(&commentListSince, "since", "", "Only comments created on or after a date (YYYY-MM-DD, or -7d, -2w)")
+	issueCommentListCmd.Flags().StringVar(&commentListOrder, "order", "desc", "Sort order by creation date (asc, desc)")
 
 	issueCommentCmd.AddCommand(issueCommentListCmd)
 }
@@ -41,9 +66,23 @@ func runIssueCommentList(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	opts := commentListOptions{Limit: commentListLimit, Order: strings.ToLower(commentListOrder)}
+	if commentListAll {
+		opts.Limit = 0
+	}
+	if opts.Order != "asc" && opts.Order != "desc" {
+		return fmt.Errorf("invalid order: %s (valid: asc, desc)", commentListOrder)
+	}
+	if commentListSince != "" {
+		opts.Since, err = parseCommentSince(commentListSince, time.Now())
+		if err != nil {
+			return err
+		}
+	}
+
 	client := api.NewClient(cfg)
 
-	comments, total, err := getComments(ctx, client, issueKey, commentListLimit)
+	comments, total, err := listComments(ctx, client, issueKey, opts)
 	if err != nil {
 		if apiErr, ok := err.(*api.APIError); ok {
 			return fmt.Errorf("API error: %w", apiErr)
@@ -64,6 +103,85 @@ func runIssueCommentList(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// parseCommentSince converts a --since value to a time: an ISO date, or a
+// number of days or weeks before now.
+func parseCommentSince(value string, now time.Time) (time.Time, error) {
+	if t, err := time.ParseInLocation("2006-01-02", value, now.Location()); err == nil {
+		return t, nil
+	}
+	if m := commentRelativeSince.FindStringSubmatch(strings.ToLower(value)); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		if m[2] == "w" {
+			n *= 7
+		}
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+		return today.AddDate(0, 0, -n), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid --since: %s (use YYYY-MM-DD, -7d, or -2w)", value)
+}
+
+// listComments pages through an issue's comments in the given order,
+// skipping those created before opts.Since. Returns the comments and the
+// number matching, which exceeds len(comments) when the limit applies.
+func listComments(ctx context.Context, client *api.Client, key string, opts commentListOptions) ([]CommentInfo, int, error) {
+	orderBy := "-created"
+	if opts.Order == "asc" {
+		orderBy = "created"
+	}
+
+	comments := []CommentInfo{}
+	matching := 0
+	startAt := 0
+	const maxPages = 100
+
+	for range maxPages {
+		path := fmt.Sprintf("/issue/%s/comment?startAt=%d&maxResults=100&orderBy=%s&expand=properties", key, startAt, orderBy)
+		body, err := client.Get(ctx, path)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		var resp commentsResponse
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return nil, 0, fmt.Errorf("failed to parse response: %w", err)
+		}
+
+		// Without a date filter the API total is exact, so stop once the
+		// limit is reached.
+		if opts.Since.IsZero() {
+			matching = resp.Total
+		}
+
+		for _, c := range resp.Comments {
+			if !opts.Since.IsZero() {
+				created, err := parseJiraTime(c.Created)
+				if err == nil && created.Before(opts.Since) {
+					if opts.Order == "desc" {
+						// Newest first: everything after this is older.
+						return comments, matching, nil
+					}
+					continue
+				}
+				matching++
+			}
+			if opts.Limit > 0 && len(comments) >= opts.Limit {
+				if opts.Since.IsZero() {
+					return comments, matching, nil
+				}
+				continue
+			}
+			comments = append(comments, commentInfoFromValue(c))
+		}
+
+		startAt += len(resp.Comments)
+		if len(resp.Comments) == 0 || startAt >= resp.Total {
+			break
+		}
+	}
+
+	return comments, matching, nil
+}
+
 func printCommentList(issueKey string, comments []CommentInfo, total int) {
 	if len(comments) == 0 {
 		fmt.Printf("No comments for %s\n", issueKey)
@@ -78,11 +196,11 @@ func printCommentList(issueKey string, comments []CommentInfo, total int) {
 
 	for _, c := range comments {
 		fmt.Println()
-		fmt.Printf("[%s] [%s] %s:\n", formatDateTime(c.Created), c.ID, c.Author)
+		fmt.Printf("[%s] [%s] %s%s:\n", formatDateTime(c.Created), c.ID, c.Author, commentRestriction(c))
 		fmt.Print(RenderMarkdown(c.Body))
 	}
 
 	if total > len(comments) {
-		fmt.Fprintf(os.Stderr, "\nShowing %d of %d comments. Use -l %d to see all.\n", len(comments), total, total)
+		fmt.Fprintf(os.Stderr, "\nShowing %d of %d comments. Use --all to see all.\n", len(comments), total)
 	}
 }
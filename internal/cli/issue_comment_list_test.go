@@ -3,9 +3,12 @@ package cli
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/grantcarthew/ajira/internal/api"
 )
@@ -13,6 +16,9 @@ import (
 // resetCommentListFlags resets all comment-list-related flag variables to their zero values.
 func resetCommentListFlags() {
 	commentListLimit = 5
+	commentListAll = false
+	commentListSince = ""
+	commentListOrder = "desc"
 }
 
 func TestCommentList_CustomLimit(t *testing.T) {
@@ -116,3 +122,208 @@ func TestCommentList_ReturnsTotal(t *testing.T) {
 		t.Errorf("expected total 15, got %d", total)
 	}
 }
+
+func TestListComments_Pages(t *testing.T) {
+	// 250 comments, one per day from 2026-01-01 in the requested order,
+	// paginated by startAt and maxResults
+	const n = 250
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		q := r.URL.Query()
+		startAt, _ := strconv.Atoi(q.Get("startAt"))
+		maxResults, _ := strconv.Atoi(q.Get("maxResults"))
+		if q.Get("expand") != "properties" {
+			t.Errorf("expected expand=properties, got %s", r.URL.RawQuery)
+		}
+
+		var comments []map[string]any
+		for i := startAt; i < n && i < startAt+maxResults; i++ {
+			day := i
+			if q.Get("orderBy") == "-created" {
+				day = n - 1 - i
+			}
+			comments = append(comments, map[string]any{
+				"id":      fmt.Sprintf("%d", 100+day),
+				"created": time.Date(2026, 1, 1+day, 9, 0, 0, 0, time.UTC).Format("2006-01-02T15:04:05.000-0700"),
+				"body":    "text",
+			})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"comments":   comments,
+			"total":      n,
+			"startAt":    startAt,
+			"maxResults": maxResults,
+		})
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+
+	tests := []struct {
+		name         string
+		opts         commentListOptions
+		wantCount    int
+		wantTotal    int
+		wantFirst    string
+		wantLast     string
+		wantRequests int
+	}{
+		{
+			name:      "all pages oldest first",
+			opts:      commentListOptions{Order: "asc"},
+			wantCount: 250, wantTotal: 250, wantFirst: "100", wantLast: "349", wantRequests: 3,
+		},
+		{
+			name:      "since newest first stops early",
+			opts:      commentListOptions{Order: "desc", Since: time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC), Limit: 5}, // day index 212
+			wantCount: 5, wantTotal: 38, wantFirst: "349", wantRequests: 1,
+		},
+		{
+			name:      "limit without since",
+			opts:      commentListOptions{Order: "desc", Limit: 3},
+			wantCount: 3, wantTotal: 250, wantFirst: "349",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests = 0
+			comments, total, err := listComments(context.Background(), client, "TEST-1", tt.opts)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(comments) != tt.wantCount || total != tt.wantTotal {
+				t.Fatalf("expected %d of %d comments, got %d of %d", tt.wantCount, tt.wantTotal, len(comments), total)
+			}
+			if comments[0].ID != tt.wantFirst {
+				t.Errorf("expected first comment %s, got %s", tt.wantFirst, comments[0].ID)
+			}
+			if tt.wantLast != "" && comments[len(comments)-1].ID != tt.wantLast {
+				t.Errorf("expected last comment %s, got %s", tt.wantLast, comments[len(comments)-1].ID)
+			}
+			if tt.wantRequests != 0 && requests != tt.wantRequests {
+				t.Errorf("expected %d requests, got %d", tt.wantRequests, requests)
+			}
+		})
+	}
+}
+
+func TestParseCommentSince(t *testing.T) {
+	now := time.Date(2026, 4, 22, 15, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{"2026-04-01", "2026-04-01", false},
+		{"-7d", "2026-04-15", false},
+		{"2w", "2026-04-08", false},
+		{"yesterday", "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := parseCommentSince(tt.input, now)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("%s: expected error", tt.input)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.input, err)
+			continue
+		}
+		if got.Format("2006-01-02") != tt.want {
+			t.Errorf("%s: expected %s, got %s", tt.input, tt.want, got.Format("2006-01-02"))
+		}
+	}
+}
+
+func TestParseCommentVisibility(t *testing.T) {
+	v, err := parseCommentVisibility("role:Developers")
+	if err != nil || v == nil || v.Type != "role" || v.Value != "Developers" {
+		t.Errorf("unexpected role visibility: %+v, %v", v, err)
+	}
+
+	v, err = parseCommentVisibility("Group:jira staff")
+	if err != nil || v == nil || v.Type != "group" || v.Value != "jira staff" {
+		t.Errorf("unexpected group visibility: %+v, %v", v, err)
+	}
+
+	if v, err := parseCommentVisibility(""); v != nil || err != nil {
+		t.Errorf("expected nil for empty visibility, got %+v, %v", v, err)
+	}
+
+	for _, bad := range []string{"Developers", "user:bob", "role:"} {
+		if _, err := parseCommentVisibility(bad); err == nil {
+			t.Errorf("expected error for %q", bad)
+		}
+	}
+}
+
+func TestCommentInfoFromValue_Restrictions(t *testing.T) {
+	var c commentValue
+	err := json.Unmarshal([]byte(`{
+		"id": "1",
+		"visibility": {"type": "role", "value": "Developers"},
+		"properties": [{"key": "sd.public.comment", "value": {"internal": true}}]
+	}`), &c)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	info := commentInfoFromValue(c)
+	if info.Visibility != "role:Developers" || !info.Internal {
+		t.Errorf("unexpected restrictions: %+v", info)
+	}
+	if got := commentRestriction(info); got != " (internal, role:Developers)" {
+		t.Errorf("unexpected restriction label: %q", got)
+	}
+}
+
+func TestEditComment_KeepsRestrictions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]json.RawMessage
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if string(req["visibility"]) != `{"type":"group","value":"staff"}` {
+			t.Errorf("unexpected visibility: %s", req["visibility"])
+		}
+		if string(req["properties"]) != `[{"key":"sd.public.comment","value":{"internal":true}}]` {
+			t.Errorf("unexpected properties: %s", req["properties"])
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(CommentResult{ID: "12345"})
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	visibility := &commentVisibility{Type: "group", Value: "staff"}
+	properties := []commentProperty{{Key: internalCommentProperty, Value: json.RawMessage(`{"internal":true}`)}}
+	if _, err := editComment(context.Background(), client, "TEST-1", "12345", "text", visibility, properties); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestDeleteComment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("expected DELETE, got %s", r.Method)
+		}
+		if r.URL.Path != "/rest/api/3/issue/TEST-1/comment/12345" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	if err := deleteComment(context.Background(), client, "TEST-1", "12345"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
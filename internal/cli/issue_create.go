@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/grantcarthew/ajira/internal/api"
 	"github.com/grantcarthew/ajira/internal/config"
@@ -35,6 +36,9 @@ type issueCreateFields struct {
 	Components  []componentName `json:"components,omitempty"`
 	FixVersions []versionName   `json:"fixVersions,omitempty"`
 	Assignee    *assigneeField  `json:"assignee,omitempty"`
+
+	TimeTracking map[string]string `json:"timetracking,omitempty"`
+	DueDate      string            `json:"duedate,omitempty"`
 }
 
 type assigneeField struct {
@@ -76,6 +80,9 @@ type createIssueOptions struct {
 	Components  []string
 	FixVersions []string
 	Assignee    string // resolved accountId; empty omits the assignee field from the request
+
+	TimeTracking map[string]string // validated originalEstimate/remainingEstimate
+	DueDate      string            // ISO date
 }
 
 var (
@@ -89,6 +96,9 @@ var (
 	createComponents  []string
 	createFixVersions []string
 	createAssignee    string
+	createEstimate    string
+	createRemaining   string
+	createDue         string
 )
 
 var issueCreateCmd = &cobra.Command{
@@ -104,7 +114,8 @@ var issueCreateCmd = &cobra.Command{
   ajira issue create -s "Task" --fix-version 1.0.0         # With fix version
   ajira issue create -s "Task" -a me                       # Assign to yourself
   ajira issue create -s "Task" -a user@example.com         # Assign by email
-  ajira issue create -s "Task" -a unassigned               # Explicitly unassigned`,
+  ajira issue create -s "Task" -a unassigned               # Explicitly unassigned
+  ajira issue create -s "Task" --estimate 1d --due +3d     # With estimate and due date`,
 	SilenceUsage: true,
 	RunE:         runIssueCreate,
 }
@@ -120,9 +131,18 @@ func init() {
 	issueCreateCmd.Flags().StringSliceVarP(&createComponents, "component", "C", nil, "Component(s) (comma-separated)")
 	issueCreateCmd.Flags().StringSliceVar(&createFixVersions, "fix-version", nil, "Fix version(s) (comma-separated)")
 	issueCreateCmd.Flags().StringVarP(&createAssignee, "assignee", "a", "", "Assignee (me, email, account ID, or unassigned)")
+	issueCreateCmd.Flags().StringVar(&createEstimate, "estimate", "", "Original estimate in Jira duration syntax (e.g. 2h, 1d 4h)")
+	issueCreateCmd.Flags().StringVar(&createRemaining, "remaining", "", "Remaining estimate in Jira duration syntax")
+	issueCreateCmd.Flags().StringVar(&createDue, "due", "", "Due date (YYYY-MM-DD, today, tomorrow, +3d, +2w, next-friday)")
 
 	_ = issueCreateCmd.MarkFlagRequired("summary")
 
+	_ = issueCreateCmd.RegisterFlagCompletionFunc("type", completeIssueTypes)
+	_ = issueCreateCmd.RegisterFlagCompletionFunc("priority", completePriorities)
+	_ = issueCreateCmd.RegisterFlagCompletionFunc("component", completeComponents)
+	_ = issueCreateCmd.RegisterFlagCompletionFunc("fix-version", completeVersions)
+	_ = issueCreateCmd.RegisterFlagCompletionFunc("assignee", completeUsers)
+
 	issueCmd.AddCommand(issueCreateCmd)
 }
 
@@ -159,6 +179,19 @@ func runIssueCreate(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to read description: %w", err)
 	}
 
+	dueDate := ""
+	if createDue != "" {
+		dueDate, err = parseDueDate(createDue, time.Now())
+		if err != nil {
+			return err
+		}
+	}
+
+	timeTracking, err := timeTrackingUpdate(ctx, client, createEstimate, createRemaining)
+	if err != nil {
+		return err
+	}
+
 	// Resolve assignee to accountId
 	assigneeAccountID, err := resolveAssigneeInput(ctx, client, cfg.Email, createAssignee)
 	if err != nil {
@@ -175,6 +208,9 @@ func runIssueCreate(cmd *cobra.Command, args []string) error {
 		Parent:      createParent,
 		Components:  createComponents,
 		FixVersions: createFixVersions,
+
+		TimeTracking: timeTracking,
+		DueDate:      dueDate,
 	}
 	if assigneeAccountID != nil {
 		opts.Assignee = *assigneeAccountID
@@ -251,6 +287,9 @@ func createIssue(ctx context.Context, client *api.Client, opts createIssueOption
 		req.Fields.Assignee = &assigneeField{AccountID: opts.Assignee}
 	}
 
+	req.Fields.TimeTracking = opts.TimeTracking
+	req.Fields.DueDate = opts.DueDate
+
 	body, err := json.Marshal(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal request: %w", err)
@@ -33,8 +33,9 @@ var issueDeleteCmd = &cobra.Command{
 		}
 		return nil
 	},
-	SilenceUsage: true,
-	RunE:         runIssueDelete,
+	SilenceUsage:      true,
+	ValidArgsFunction: completeIssueKeys,
+	RunE:              runIssueDelete,
 }
 
 func init() {
@@ -60,6 +61,9 @@ func runIssueDelete(cmd *cobra.Command, args []string) error {
 		if err != nil {
 			return err
 		}
+		if err := checkBatchPolicy(len(issueKeys)); err != nil {
+			return err
+		}
 		if len(issueKeys) == 0 {
 			return fmt.Errorf("no issue keys provided via stdin")
 		}
@@ -101,7 +105,7 @@ func runIssueDelete(cmd *cobra.Command, args []string) error {
 	for _, key := range issueKeys {
 		err := deleteIssue(ctx, client, key, deleteCascade)
 		if err != nil {
-			results = append(results, BatchResult{Key: key, Success: false, Error: err.Error()})
+			results = append(results, failedResult(key, err))
 		} else {
 			results = append(results, BatchResult{Key: key, Success: true})
 		}
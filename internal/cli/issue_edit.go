@@ -6,12 +6,15 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/grantcarthew/ajira/internal/api"
 	"github.com/grantcarthew/ajira/internal/config"
 	"github.com/grantcarthew/ajira/internal/converter"
 	"github.com/grantcarthew/ajira/internal/jira"
+	"github.com/grantcarthew/ajira/internal/journal"
 	"github.com/spf13/cobra"
 )
 
@@ -37,12 +40,23 @@ var (
 	editFixVersions       []string
 	editAddFixVersions    []string
 	editRemoveFixVersions []string
+	editEstimate          string
+	editRemaining         string
+	editDue               string
+	editJQL               string
+	editYes               bool
 )
 
+// editPreviewFields are the fields fetched to preview --jql and --dry-run edits.
+const editPreviewFields = "summary,description,issuetype,priority,labels,parent,components,fixVersions,duedate,timetracking"
+
 var issueEditCmd = &cobra.Command{
-	Use:   "edit <issue-key>",
+	Use:   "edit [issue-key]",
 	Short: "Edit issue",
-	Long:  "Update issue fields. Supports summary, description, type, priority, labels, components, and versions.",
+	Long: `Update issue fields. Supports summary, description, type, priority, labels, components, versions, estimates, and due date.
+
+Use --jql to apply the same change to every matching issue. --dry-run previews the field changes
+per issue; changing more than 50 issues requires --yes.`,
 	Example: `  ajira issue edit PROJ-123 -s "New summary"          # Update summary
   ajira issue edit PROJ-123 -d "New description"      # Update description
   ajira issue edit PROJ-123 -t Bug --priority High    # Change type and priority
@@ -50,10 +64,25 @@ var issueEditCmd = &cobra.Command{
   ajira issue edit PROJ-123 --parent none             # Remove parent
   ajira issue edit PROJ-123 --add-labels urgent       # Add label
   ajira issue edit PROJ-123 --add-component Frontend  # Add component
-  ajira issue edit PROJ-123 --add-fix-version 1.1.0   # Add fix version`,
-	Args:         cobra.ExactArgs(1),
-	SilenceUsage: true,
-	RunE:         runIssueEdit,
+  ajira issue edit PROJ-123 --add-fix-version 1.1.0   # Add fix version
+  ajira issue edit PROJ-123 --remaining 3h            # Update remaining estimate
+  ajira issue edit PROJ-123 --due next-friday         # Set due date
+  ajira issue edit PROJ-123 --due none                # Clear due date
+  ajira issue edit --jql "labels = legacy" --remove-labels legacy --add-labels archived --dry-run
+  ajira issue edit --jql "project = PROJ AND fixVersion = 1.0" --fix-version 1.1 --yes`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if editJQL != "" {
+			if len(args) != 0 {
+				return fmt.Errorf("with --jql, no issue key is accepted")
+			}
+		} else if len(args) != 1 {
+			return fmt.Errorf("requires exactly 1 argument: <issue-key>")
+		}
+		return nil
+	},
+	SilenceUsage:      true,
+	ValidArgsFunction: completeFirstArg(completeIssueKeys),
+	RunE:              runIssueEdit,
 }
 
 func init() {
@@ -73,6 +102,16 @@ func init() {
 	issueEditCmd.Flags().StringSliceVar(&editFixVersions, "fix-version", nil, "Replace all fix versions")
 	issueEditCmd.Flags().StringSliceVar(&editAddFixVersions, "add-fix-version", nil, "Add fix version(s)")
 	issueEditCmd.Flags().StringSliceVar(&editRemoveFixVersions, "remove-fix-version", nil, "Remove fix version(s)")
+	issueEditCmd.Flags().StringVar(&editEstimate, "estimate", "", "Original estimate in Jira duration syntax (e.g. 2h, 1d 4h)")
+	issueEditCmd.Flags().StringVar(&editRemaining, "remaining", "", "Remaining estimate in Jira duration syntax")
+	issueEditCmd.Flags().StringVar(&editDue, "due", "", "Due date (YYYY-MM-DD, today, tomorrow, +3d, +2w, next-friday, or none to clear)")
+	issueEditCmd.Flags().StringVar(&editJQL, "jql", "", "Edit all issues matching a JQL query")
+	issueEditCmd.Flags().BoolVar(&editYes, "yes", false, "Confirm changes to more than 50 issues matched by --jql")
+
+	_ = issueEditCmd.RegisterFlagCompletionFunc("type", completeIssueTypes)
+	_ = issueEditCmd.RegisterFlagCompletionFunc("priority", completePriorities)
+	_ = issueEditCmd.RegisterFlagCompletionFunc("component", completeComponents)
+	_ = issueEditCmd.RegisterFlagCompletionFunc("fix-version", completeVersions)
 
 	issueCmd.AddCommand(issueEditCmd)
 }
@@ -90,7 +129,6 @@ func isParentRemovalKeyword(value string) bool {
 
 func runIssueEdit(cmd *cobra.Command, args []string) error {
 	ctx := cmd.Context()
-	issueKey := args[0]
 
 	// Check if parent flag was explicitly provided
 	parentChanged := cmd.Flags().Changed("parent")
@@ -100,7 +138,8 @@ func runIssueEdit(cmd *cobra.Command, args []string) error {
 		editType != "" || editPriority != "" || editLabels != nil || parentChanged ||
 		editAddLabels != nil || editRemoveLabels != nil ||
 		editComponents != nil || editAddComponents != nil || editRemoveComponents != nil ||
-		editFixVersions != nil || editAddFixVersions != nil || editRemoveFixVersions != nil
+		editFixVersions != nil || editAddFixVersions != nil || editRemoveFixVersions != nil ||
+		editEstimate != "" || editRemaining != "" || editDue != ""
 
 	if !hasChanges {
 		return fmt.Errorf("no fields to update")
@@ -124,18 +163,60 @@ func runIssueEdit(cmd *cobra.Command, args []string) error {
 
 	client := api.NewClient(cfg)
 
-	// Extract project key from issue key for validation
-	projectKey := extractProjectKey(issueKey)
+	if err := jira.ValidatePriority(ctx, client, editPriority); err != nil {
+		return err
+	}
 
-	// Validate issue type and priority before making the update request
-	if err := jira.ValidateIssueType(ctx, client, projectKey, editType); err != nil {
+	fields, update, description, err := buildEditRequest(ctx, client, parentChanged)
+	if err != nil {
 		return err
 	}
-	if err := jira.ValidatePriority(ctx, client, editPriority); err != nil {
+
+	if editJQL != "" {
+		return runIssueEditMany(ctx, client, editJQL, fields, update, description)
+	}
+
+	issueKey := args[0]
+
+	// Validate issue type before making the update request
+	if err := jira.ValidateIssueType(ctx, client, extractProjectKey(issueKey), editType); err != nil {
+		return err
+	}
+
+	if DryRun() {
+		return runIssueEditMany(ctx, client, keysJQL([]string{issueKey}), fields, update, description)
+	}
+
+	updateCtx, err := undoContext(ctx, client, journal.KindEdit, issueKey, editedFieldIDs(fields, update), nil)
+	if err != nil {
 		return err
 	}
 
-	// Build fields to update
+	err = updateIssue(updateCtx, client, issueKey, fields, update)
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); ok {
+			return fmt.Errorf("API error: %w", apiErr)
+		}
+		return fmt.Errorf("failed to update issue: %w", err)
+	}
+
+	if JSONOutput() {
+		result := map[string]string{"key": issueKey, "status": "updated"}
+		output, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to format JSON: %w", err)
+		}
+		fmt.Println(string(output))
+	} else {
+		fmt.Println(IssueURL(cfg.BaseURL, issueKey))
+	}
+
+	return nil
+}
+
+// buildEditRequest builds the fields and update operations from the edit
+// flags. The description is also returned as Markdown for previews.
+func buildEditRequest(ctx context.Context, client *api.Client, parentChanged bool) (map[string]any, map[string]any, string, error) {
 	fields := make(map[string]any)
 
 	if editSummary != "" {
@@ -148,13 +229,13 @@ func runIssueEdit(cmd *cobra.Command, args []string) error {
 		if editFile == "-" {
 			data, err := io.ReadAll(os.Stdin)
 			if err != nil {
-				return fmt.Errorf("failed to read stdin: %w", err)
+				return nil, nil, "", fmt.Errorf("failed to read stdin: %w", err)
 			}
 			description = string(data)
 		} else {
 			data, err := os.ReadFile(editFile)
 			if err != nil {
-				return fmt.Errorf("failed to read file: %w", err)
+				return nil, nil, "", fmt.Errorf("failed to read file: %w", err)
 			}
 			description = string(data)
 		}
@@ -163,7 +244,7 @@ func runIssueEdit(cmd *cobra.Command, args []string) error {
 	if description != "" {
 		adf, err := converter.MarkdownToADF(description)
 		if err != nil {
-			return fmt.Errorf("failed to convert description: %w", err)
+			return nil, nil, "", fmt.Errorf("failed to convert description: %w", err)
 		}
 		fields["description"] = adf
 	}
@@ -208,11 +289,29 @@ func runIssueEdit(cmd *cobra.Command, args []string) error {
 		fields["fixVersions"] = versions
 	}
 
+	if editDue != "" {
+		if strings.EqualFold(editDue, "none") {
+			fields["duedate"] = nil
+		} else {
+			due, err := parseDueDate(editDue, time.Now())
+			if err != nil {
+				return nil, nil, "", err
+			}
+			fields["duedate"] = due
+		}
+	}
+
+	timeTracking, err := timeTrackingUpdate(ctx, client, editEstimate, editRemaining)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
 	// Build update map for add/remove operations
 	var update map[string]any
 	needsUpdate := editAddLabels != nil || editRemoveLabels != nil ||
 		editAddComponents != nil || editRemoveComponents != nil ||
-		editAddFixVersions != nil || editRemoveFixVersions != nil
+		editAddFixVersions != nil || editRemoveFixVersions != nil ||
+		timeTracking != nil
 
 	if needsUpdate {
 		update = make(map[string]any)
@@ -254,26 +353,27 @@ func runIssueEdit(cmd *cobra.Command, args []string) error {
 		update["fixVersions"] = versionOps
 	}
 
-	err = updateIssue(ctx, client, issueKey, fields, update)
-	if err != nil {
-		if apiErr, ok := err.(*api.APIError); ok {
-			return fmt.Errorf("API error: %w", apiErr)
-		}
-		return fmt.Errorf("failed to update issue: %w", err)
+	// Estimates are edited in place so the one not given is kept
+	if timeTracking != nil {
+		update["timetracking"] = []map[string]any{{"edit": timeTracking}}
 	}
 
-	if JSONOutput() {
-		result := map[string]string{"key": issueKey, "status": "updated"}
-		output, err := json.MarshalIndent(result, "", "  ")
-		if err != nil {
-			return fmt.Errorf("failed to format JSON: %w", err)
+	return fields, update, description, nil
+}
+
+// editedFieldIDs returns the IDs of the fields an edit changes.
+func editedFieldIDs(fields, update map[string]any) []string {
+	ids := make([]string, 0, len(fields)+len(update))
+	for id := range fields {
+		ids = append(ids, id)
+	}
+	for id := range update {
+		if _, ok := fields[id]; !ok {
+			ids = append(ids, id)
 		}
-		fmt.Println(string(output))
-	} else {
-		fmt.Println(IssueURL(cfg.BaseURL, issueKey))
 	}
-
-	return nil
+	slices.Sort(ids)
+	return ids
 }
 
 func updateIssue(ctx context.Context, client *api.Client, key string, fields, update map[string]any) error {
@@ -291,3 +391,133 @@ func updateIssue(ctx context.Context, client *api.Client, key string, fields, up
 	_, err = client.Put(ctx, path, body)
 	return err
 }
+
+// runIssueEditMany applies an edit to every issue matching jql, or previews
+// the changes under --dry-run.
+func runIssueEditMany(ctx context.Context, client *api.Client, jql string, fields, update map[string]any, description string) error {
+	issues, err := resolveJQLIssues(ctx, client, jql, editPreviewFields, editYes)
+	if err != nil {
+		return err
+	}
+
+	if editType != "" {
+		validated := make(map[string]bool)
+		for _, issue := range issues {
+			projectKey := extractProjectKey(issue.Key)
+			if validated[projectKey] {
+				continue
+			}
+			if err := jira.ValidateIssueType(ctx, client, projectKey, editType); err != nil {
+				return err
+			}
+			validated[projectKey] = true
+		}
+	}
+
+	if DryRun() {
+		var previews []IssuePreview
+		for _, issue := range issues {
+			changes := editChanges(issue, fields, update, description)
+			if len(changes) > 0 {
+				previews = append(previews, IssuePreview{Key: issue.Key, Summary: issue.Fields.Summary, Changes: changes})
+			}
+		}
+		printIssuePreviews(previews, len(issues))
+		return nil
+	}
+
+	var results []BatchResult
+	for _, issue := range issues {
+		result := BatchResult{Key: issue.Key, Success: true}
+		updateCtx, err := undoContext(ctx, client, journal.KindEdit, issue.Key, editedFieldIDs(fields, update), issue.Fields.Raw)
+		if err == nil {
+			err = updateIssue(updateCtx, client, issue.Key, fields, update)
+		}
+		if err != nil {
+			result = failedResult(result.Key, err)
+		}
+		results = append(results, result)
+	}
+	return PrintBatchResults(results)
+}
+
+// editChanges compares an issue with the edit flags and returns the field
+// changes the edit would make.
+func editChanges(issue issueValue, fields, update map[string]any, description string) []FieldChange {
+	raw := issue.Fields.Raw
+	var changes []FieldChange
+
+	if editSummary != "" {
+		changes = appendFieldChange(changes, "summary", issue.Fields.Summary, editSummary)
+	}
+	if _, ok := fields["description"]; ok {
+		current := fieldText(raw["description"])
+		if current != strings.TrimSpace(description) {
+			changes = append(changes, FieldChange{Field: "description", From: previewText(current), To: previewText(description)})
+		}
+	}
+	if editType != "" {
+		changes = appendFieldChange(changes, "issuetype", fieldText(raw["issuetype"]), editType)
+	}
+	if editPriority != "" {
+		changes = appendFieldChange(changes, "priority", fieldText(raw["priority"]), editPriority)
+	}
+	if parent, ok := fields["parent"]; ok {
+		var current struct {
+			Key string `json:"key"`
+		}
+		_ = json.Unmarshal(raw["parent"], &current)
+		to := ""
+		if p, ok := parent.(map[string]string); ok {
+			to = p["key"]
+		}
+		changes = appendFieldChange(changes, "parent", current.Key, to)
+	}
+
+	changes = appendListChange(changes, "labels", raw["labels"], editLabels, editAddLabels, editRemoveLabels)
+	changes = appendListChange(changes, "components", raw["components"], editComponents, editAddComponents, editRemoveComponents)
+	changes = appendListChange(changes, "fixVersions", raw["fixVersions"], editFixVersions, editAddFixVersions, editRemoveFixVersions)
+
+	if due, ok := fields["duedate"]; ok {
+		to, _ := due.(string)
+		changes = appendFieldChange(changes, "duedate", fieldText(raw["duedate"]), to)
+	}
+	if tt := timeTrackingEdit(update); tt != nil {
+		var current TimeTracking
+		_ = json.Unmarshal(raw["timetracking"], &current)
+		if to, ok := tt["originalEstimate"]; ok {
+			changes = appendFieldChange(changes, "originalEstimate", current.OriginalEstimate, to)
+		}
+		if to, ok := tt["remainingEstimate"]; ok {
+			changes = appendFieldChange(changes, "remainingEstimate", current.RemainingEstimate, to)
+		}
+	}
+
+	return changes
+}
+
+// timeTrackingEdit returns the estimates set by a timetracking edit
+// operation in update, or nil if there is none.
+func timeTrackingEdit(update map[string]any) map[string]string {
+	ops, _ := update["timetracking"].([]map[string]any)
+	for _, op := range ops {
+		if tt, ok := op["edit"].(map[string]string); ok {
+			return tt
+		}
+	}
+	return nil
+}
+
+// appendListChange appends the change to a list field made by a replace
+// list or add and remove lists. Nothing is appended if no list is set.
+func appendListChange(changes []FieldChange, field string, raw json.RawMessage, replace, add, remove []string) []FieldChange {
+	if replace == nil && add == nil && remove == nil {
+		return changes
+	}
+	current := fieldNames(raw)
+	next := replace
+	if next == nil {
+		next = applyListChange(current, add, remove)
+	}
+	return appendFieldChange(changes, field, strings.Join(current, ", "), strings.Join(next, ", "))
+}
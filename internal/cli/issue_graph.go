@@ -0,0 +1,441 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/grantcarthew/ajira/internal/api"
+	"github.com/grantcarthew/ajira/internal/config"
+	"github.com/grantcarthew/ajira/internal/width"
+	"github.com/spf13/cobra"
+)
+
+// maxBlockedChains caps the number of blocked-by chains reported so highly
+// connected graphs do not produce unbounded output.
+const maxBlockedChains = 100
+
+// IssueGraph represents a dependency graph built from issue links.
+type IssueGraph struct {
+	Root          string      `json:"root"`
+	Nodes         []GraphNode `json:"nodes"`
+	Edges         []GraphEdge `json:"edges"`
+	BlockedChains [][]string  `json:"blockedChains,omitempty"`
+}
+
+// GraphNode represents an issue in a dependency graph.
+type GraphNode struct {
+	Key            string `json:"key"`
+	Summary        string `json:"summary"`
+	Status         string `json:"status"`
+	StatusCategory string `json:"statusCategory"`
+	Type           string `json:"type,omitempty"`
+	Depth          int    `json:"depth"`
+	Blocked        bool   `json:"blocked"`
+}
+
+// GraphEdge represents a directed link that reads as: From Label To.
+type GraphEdge struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Type  string `json:"type"`
+	Label string `json:"label"`
+}
+
+// graphIssueResponse matches the Jira issue API response for graph traversal.
+type graphIssueResponse struct {
+	Key    string           `json:"key"`
+	Fields graphIssueFields `json:"fields"`
+}
+
+type graphIssueFields struct {
+	Summary    string       `json:"summary"`
+	Status     *statusField `json:"status"`
+	IssueType  *issueType   `json:"issuetype"`
+	IssueLinks []issueLink  `json:"issuelinks"`
+}
+
+var (
+	issueGraphDepth  int
+	issueGraphTypes  []string
+	issueGraphFormat string
+)
+
+var issueGraphCmd = &cobra.Command{
+	Use:   "graph <issue-key>",
+	Short: "Show link graph",
+	Long:  "Walk issue links breadth-first and output a dependency graph as text, JSON, Mermaid, or DOT. Flags chains of unresolved blockers.",
+	Example: `  ajira issue graph GCP-10                          # Two levels of all link types
+  ajira issue graph GCP-10 --depth 4 --types blocks # Follow blocking links only
+  ajira issue graph GCP-10 --format mermaid         # Mermaid flowchart
+  ajira issue graph GCP-10 --format dot | dot -Tsvg > deps.svg`,
+	Args:              cobra.ExactArgs(1),
+	SilenceUsage:      true,
+	ValidArgsFunction: completeFirstArg(completeIssueKeys),
+	RunE:              runIssueGraph,
+}
+
+func init() {
+	issueGraphCmd.Flags().IntVar(&issueGraphDepth, "depth", 2, "Maximum link hops from the root issue")
+	issueGraphCmd.Flags().StringSliceVar(&issueGraphTypes, "types", nil, "Link types to follow (comma-separated, default all)")
+	issueGraphCmd.Flags().StringVar(&issueGraphFormat, "format", "text", "Output format: text, json, mermaid, dot")
+
+	issueCmd.AddCommand(issueGraphCmd)
+}
+
+func runIssueGraph(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	issueKey := args[0]
+
+	if issueGraphDepth < 1 {
+		return fmt.Errorf("--depth must be at least 1")
+	}
+
+	format := strings.ToLower(issueGraphFormat)
+	if JSONOutput() {
+		format = "json"
+	}
+	switch format {
+	case "text", "json", "mermaid", "dot":
+	default:
+		return fmt.Errorf("invalid format %q, valid options: text, json, mermaid, dot", issueGraphFormat)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(cfg)
+
+	graph, err := buildIssueGraph(ctx, client, issueKey, issueGraphDepth, issueGraphTypes)
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); ok {
+			return fmt.Errorf("API error: %w", apiErr)
+		}
+		return fmt.Errorf("failed to build issue graph: %w", err)
+	}
+
+	switch format {
+	case "json":
+		output, err := json.MarshalIndent(graph, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to format JSON: %w", err)
+		}
+		fmt.Println(string(output))
+	case "mermaid":
+		writeGraphMermaid(os.Stdout, graph)
+	case "dot":
+		writeGraphDOT(os.Stdout, graph)
+	default:
+		printIssueGraph(graph)
+	}
+
+	return nil
+}
+
+// buildIssueGraph walks issue links breadth-first from root up to depth hops.
+// Each level is fetched concurrently. Visited issues are tracked so cycles are
+// traversed once, and edges are deduplicated since both ends report a link.
+func buildIssueGraph(ctx context.Context, client *api.Client, root string, depth int, types []string) (*IssueGraph, error) {
+	graph := &IssueGraph{Root: root}
+
+	nodes := make(map[string]*GraphNode)
+	var order []string
+	seenEdges := make(map[string]bool)
+
+	addNode := func(n GraphNode) {
+		nodes[n.Key] = &n
+		order = append(order, n.Key)
+	}
+
+	addNode(GraphNode{Key: root})
+	level := []string{root}
+
+	for d := 0; d <= depth && len(level) > 0; d++ {
+		fetched, err := fetchGraphIssues(ctx, client, level)
+		if err != nil {
+			return nil, err
+		}
+
+		var next []string
+		for i, key := range level {
+			issue := fetched[i]
+			node := nodes[key]
+			node.Summary = issue.Fields.Summary
+			if issue.Fields.Status != nil {
+				node.Status = issue.Fields.Status.Name
+				if issue.Fields.Status.StatusCategory != nil {
+					node.StatusCategory = issue.Fields.Status.StatusCategory.Key
+				}
+			}
+			if issue.Fields.IssueType != nil {
+				node.Type = issue.Fields.IssueType.Name
+			}
+
+			// Issues at the depth boundary are fetched for their own details
+			// only; their links lead outside the requested graph.
+			if d == depth {
+				continue
+			}
+
+			for _, link := range issue.Fields.IssueLinks {
+				if !linkTypeSelected(link.Type, types) {
+					continue
+				}
+
+				var other *linkedIssue
+				edge := GraphEdge{Type: link.Type.Name, Label: link.Type.Outward}
+				if link.OutwardIssue != nil {
+					other = link.OutwardIssue
+					edge.From, edge.To = key, other.Key
+				} else if link.InwardIssue != nil {
+					other = link.InwardIssue
+					edge.From, edge.To = other.Key, key
+				} else {
+					continue
+				}
+
+				edgeID := edge.From + "|" + edge.Type + "|" + edge.To
+				if !seenEdges[edgeID] {
+					seenEdges[edgeID] = true
+					graph.Edges = append(graph.Edges, edge)
+				}
+
+				if _, ok := nodes[other.Key]; !ok {
+					addNode(GraphNode{Key: other.Key, Depth: d + 1})
+					next = append(next, other.Key)
+				}
+			}
+		}
+
+		level = next
+	}
+
+	for _, key := range order {
+		graph.Nodes = append(graph.Nodes, *nodes[key])
+	}
+
+	markBlocked(graph)
+
+	return graph, nil
+}
+
+// fetchGraphIssues fetches issues with their links, preserving input order.
+func fetchGraphIssues(ctx context.Context, client *api.Client, keys []string) ([]graphIssueResponse, error) {
+	results := make([]graphIssueResponse, len(keys))
+	errs := make([]error, len(keys))
+	sem := make(chan struct{}, treeConcurrency)
+
+	var wg sync.WaitGroup
+	for i, key := range keys {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			path := fmt.Sprintf("/issue/%s?fields=summary,status,issuetype,issuelinks", key)
+			body, err := client.Get(ctx, path)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			if err := json.Unmarshal(body, &results[i]); err != nil {
+				errs[i] = fmt.Errorf("failed to parse response: %w", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// linkTypeSelected reports whether a link type matches the --types filter.
+// Matches the type name or either direction's text, case-insensitively.
+func linkTypeSelected(lt issueLinkType, types []string) bool {
+	if len(types) == 0 {
+		return true
+	}
+	for _, t := range types {
+		t = strings.TrimSpace(t)
+		if strings.EqualFold(t, lt.Name) || strings.EqualFold(t, lt.Outward) || strings.EqualFold(t, lt.Inward) {
+			return true
+		}
+	}
+	return false
+}
+
+// isBlockingEdge reports whether an edge represents From blocking To.
+func isBlockingEdge(e GraphEdge) bool {
+	return strings.EqualFold(e.Type, "Blocks")
+}
+
+// markBlocked flags nodes with at least one unresolved blocker and collects
+// chains of unresolved blockers. A chain reads from the blocked issue back to
+// the root cause: [C, B, A] means C is blocked by B, which is blocked by A.
+func markBlocked(graph *IssueGraph) {
+	index := make(map[string]int, len(graph.Nodes))
+	for i, n := range graph.Nodes {
+		index[n.Key] = i
+	}
+
+	unresolved := func(key string) bool {
+		i, ok := index[key]
+		return ok && graph.Nodes[i].StatusCategory != "done"
+	}
+
+	// blockers maps a blocked issue to its unresolved blockers
+	blockers := make(map[string][]string)
+	isBlocker := make(map[string]bool)
+	for _, e := range graph.Edges {
+		if !isBlockingEdge(e) || !unresolved(e.From) || !unresolved(e.To) {
+			continue
+		}
+		blockers[e.To] = append(blockers[e.To], e.From)
+		isBlocker[e.From] = true
+	}
+
+	var blocked []string
+	for key := range blockers {
+		graph.Nodes[index[key]].Blocked = true
+		blocked = append(blocked, key)
+	}
+	sort.Strings(blocked)
+
+	// visited holds the issues reached by a walk so far
+	visited := make(map[string]bool)
+
+	var walk func(path []string, onPath map[string]bool)
+	walk = func(path []string, onPath map[string]bool) {
+		if len(graph.BlockedChains) >= maxBlockedChains {
+			return
+		}
+		last := path[len(path)-1]
+		extended := false
+		for _, b := range blockers[last] {
+			if onPath[b] {
+				continue
+			}
+			extended = true
+			visited[b] = true
+			onPath[b] = true
+			walk(append(path[:len(path):len(path)], b), onPath)
+			delete(onPath, b)
+		}
+		if !extended && len(path) > 1 {
+			graph.BlockedChains = append(graph.BlockedChains, path)
+		}
+	}
+
+	start := func(head string) {
+		visited[head] = true
+		walk([]string{head}, map[string]bool{head: true})
+	}
+
+	// Chains start at blocked issues that are not themselves blocking anything.
+	// Issues blocked only from within a cycle have no such head, so each one
+	// not reached yet starts a chain of its own.
+	for _, key := range blocked {
+		if !isBlocker[key] {
+			start(key)
+		}
+	}
+	for _, key := range blocked {
+		if !visited[key] {
+			start(key)
+		}
+	}
+}
+
+func printIssueGraph(graph *IssueGraph) {
+	fmt.Printf("Dependency graph for %s (%d issues, %d links):\n\n", graph.Root, len(graph.Nodes), len(graph.Edges))
+
+	for _, n := range graph.Nodes {
+		indent := strings.Repeat("  ", n.Depth)
+		line := fmt.Sprintf("%s%s (%s) %s", indent, n.Key, colorStatus(n.Status, n.StatusCategory), width.Truncate(n.Summary, 60, "..."))
+		if n.Blocked {
+			line += "  [blocked]"
+		}
+		fmt.Println(line)
+	}
+
+	if len(graph.Edges) > 0 {
+		fmt.Println()
+		fmt.Println("Links:")
+		for _, e := range graph.Edges {
+			fmt.Printf("  %s %s %s\n", e.From, e.Label, e.To)
+		}
+	}
+
+	if len(graph.BlockedChains) > 0 {
+		fmt.Println()
+		fmt.Println("Blocked chains (unresolved blockers):")
+		for _, chain := range graph.BlockedChains {
+			fmt.Printf("  %s\n", strings.Join(chain, " <- "))
+		}
+	}
+}
+
+// mermaidID converts an issue key to a Mermaid-safe node identifier.
+func mermaidID(key string) string {
+	return strings.ReplaceAll(key, "-", "_")
+}
+
+func writeGraphMermaid(w io.Writer, graph *IssueGraph) {
+	fmt.Fprintln(w, "graph LR")
+	for _, n := range graph.Nodes {
+		label := strings.ReplaceAll(fmt.Sprintf("%s: %s", n.Key, n.Summary), `"`, "#quot;")
+		fmt.Fprintf(w, "  %s[\"%s\"]\n", mermaidID(n.Key), label)
+	}
+	for _, e := range graph.Edges {
+		fmt.Fprintf(w, "  %s -->|%s| %s\n", mermaidID(e.From), e.Label, mermaidID(e.To))
+	}
+
+	var blocked []string
+	for _, n := range graph.Nodes {
+		if n.Blocked {
+			blocked = append(blocked, mermaidID(n.Key))
+		}
+	}
+	if len(blocked) > 0 {
+		fmt.Fprintln(w, "  classDef blocked fill:#fdd,stroke:#c00")
+		fmt.Fprintf(w, "  class %s blocked\n", strings.Join(blocked, ","))
+	}
+}
+
+// dotEscape escapes a string for use inside a quoted DOT identifier or label.
+func dotEscape(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return strings.ReplaceAll(s, `"`, `\"`)
+}
+
+// dotQuote quotes a string for use as a DOT identifier or label.
+func dotQuote(s string) string {
+	return `"` + dotEscape(s) + `"`
+}
+
+func writeGraphDOT(w io.Writer, graph *IssueGraph) {
+	fmt.Fprintln(w, "digraph issues {")
+	fmt.Fprintln(w, "  rankdir=LR;")
+	for _, n := range graph.Nodes {
+		attrs := fmt.Sprintf(`label="%s\n%s"`, dotEscape(n.Key), dotEscape(n.Summary))
+		if n.Blocked {
+			attrs += ", color=red"
+		}
+		fmt.Fprintf(w, "  %s [%s];\n", dotQuote(n.Key), attrs)
+	}
+	for _, e := range graph.Edges {
+		fmt.Fprintf(w, "  %s -> %s [label=%s];\n", dotQuote(e.From), dotQuote(e.To), dotQuote(e.Label))
+	}
+	fmt.Fprintln(w, "}")
+}
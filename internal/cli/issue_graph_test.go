@@ -0,0 +1,211 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/grantcarthew/ajira/internal/api"
+)
+
+// blockedChainRoutes: A blocks B, B blocks C, C relates to A (cycle).
+// D (done) blocks C.
+var blockedChainRoutes = map[string]any{
+	"/rest/api/3/issue/GCP-A": `{"key": "GCP-A", "fields": {"summary": "Summary GCP-A", "status": {"statusCategory": {"key": "indeterminate"}}, "issuelinks": [
+		{"id": "1", "type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"}, "outwardIssue": {"key": "GCP-B", "fields": {"status": {"statusCategory": {"key": "new"}}}}},
+		{"id": "3", "type": {"name": "Relates", "inward": "relates to", "outward": "relates to"}, "inwardIssue": {"key": "GCP-C", "fields": {"status": {"statusCategory": {"key": "new"}}}}}
+	]}}`,
+	"/rest/api/3/issue/GCP-B": `{"key": "GCP-B", "fields": {"summary": "Summary GCP-B", "status": {"statusCategory": {"key": "new"}}, "issuelinks": [
+		{"id": "1", "type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"}, "inwardIssue": {"key": "GCP-A", "fields": {"status": {"statusCategory": {"key": "indeterminate"}}}}},
+		{"id": "2", "type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"}, "outwardIssue": {"key": "GCP-C", "fields": {"status": {"statusCategory": {"key": "new"}}}}}
+	]}}`,
+	"/rest/api/3/issue/GCP-C": `{"key": "GCP-C", "fields": {"summary": "Summary GCP-C", "status": {"statusCategory": {"key": "new"}}, "issuelinks": [
+		{"id": "2", "type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"}, "inwardIssue": {"key": "GCP-B", "fields": {"status": {"statusCategory": {"key": "new"}}}}},
+		{"id": "3", "type": {"name": "Relates", "inward": "relates to", "outward": "relates to"}, "outwardIssue": {"key": "GCP-A", "fields": {"status": {"statusCategory": {"key": "indeterminate"}}}}},
+		{"id": "4", "type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"}, "inwardIssue": {"key": "GCP-D", "fields": {"status": {"statusCategory": {"key": "done"}}}}}
+	]}}`,
+	"/rest/api/3/issue/GCP-D": `{"key": "GCP-D", "fields": {"summary": "Summary GCP-D", "status": {"statusCategory": {"key": "done"}}, "issuelinks": [
+		{"id": "4", "type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"}, "outwardIssue": {"key": "GCP-C", "fields": {"status": {"statusCategory": {"key": "new"}}}}}
+	]}}`,
+}
+
+func TestBuildIssueGraph_DedupesCycles(t *testing.T) {
+	server := jsonServer(blockedChainRoutes)
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	graph, err := buildIssueGraph(context.Background(), client, "GCP-A", 3, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(graph.Nodes) != 4 {
+		t.Errorf("expected 4 nodes, got %d: %+v", len(graph.Nodes), graph.Nodes)
+	}
+	if len(graph.Edges) != 4 {
+		t.Errorf("expected 4 deduplicated edges, got %d: %+v", len(graph.Edges), graph.Edges)
+	}
+
+	for _, e := range graph.Edges {
+		if e.From == "GCP-A" && e.To == "GCP-B" && e.Label != "blocks" {
+			t.Errorf("expected A blocks B, got %+v", e)
+		}
+	}
+}
+
+func TestBuildIssueGraph_TypeFilter(t *testing.T) {
+	server := jsonServer(blockedChainRoutes)
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	graph, err := buildIssueGraph(context.Background(), client, "GCP-A", 3, []string{"relates"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(graph.Edges) != 1 {
+		t.Fatalf("expected 1 relates edge, got %d: %+v", len(graph.Edges), graph.Edges)
+	}
+	if graph.Edges[0].Type != "Relates" {
+		t.Errorf("expected Relates edge, got %s", graph.Edges[0].Type)
+	}
+	if len(graph.BlockedChains) != 0 {
+		t.Errorf("expected no blocked chains, got %v", graph.BlockedChains)
+	}
+}
+
+func TestBuildIssueGraph_DepthLimit(t *testing.T) {
+	server := jsonServer(blockedChainRoutes)
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	graph, err := buildIssueGraph(context.Background(), client, "GCP-A", 1, []string{"blocks"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(graph.Nodes) != 2 {
+		t.Errorf("expected root and one neighbour, got %+v", graph.Nodes)
+	}
+	for _, n := range graph.Nodes {
+		if n.Key == "GCP-B" && n.Depth != 1 {
+			t.Errorf("expected GCP-B at depth 1, got %d", n.Depth)
+		}
+	}
+}
+
+func TestBuildIssueGraph_BlockedChains(t *testing.T) {
+	server := jsonServer(blockedChainRoutes)
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	graph, err := buildIssueGraph(context.Background(), client, "GCP-A", 3, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(graph.BlockedChains) != 1 {
+		t.Fatalf("expected 1 blocked chain, got %v", graph.BlockedChains)
+	}
+	got := strings.Join(graph.BlockedChains[0], ",")
+	if got != "GCP-C,GCP-B,GCP-A" {
+		t.Errorf("expected chain C <- B <- A, got %s", got)
+	}
+
+	blocked := make(map[string]bool)
+	for _, n := range graph.Nodes {
+		blocked[n.Key] = n.Blocked
+	}
+	if !blocked["GCP-B"] || !blocked["GCP-C"] {
+		t.Errorf("expected B and C blocked, got %v", blocked)
+	}
+	if blocked["GCP-A"] || blocked["GCP-D"] {
+		t.Errorf("expected A and D not blocked, got %v", blocked)
+	}
+}
+
+func TestBuildIssueGraph_BlockedCycle(t *testing.T) {
+	// A blocks B and B blocks A; neither is blocked from outside the cycle.
+	server := jsonServer(map[string]any{
+		"/rest/api/3/issue/GCP-A": `{"key": "GCP-A", "fields": {"status": {"statusCategory": {"key": "new"}}, "issuelinks": [
+			{"id": "1", "type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"}, "outwardIssue": {"key": "GCP-B", "fields": {"status": {"statusCategory": {"key": "new"}}}}},
+			{"id": "2", "type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"}, "inwardIssue": {"key": "GCP-B", "fields": {"status": {"statusCategory": {"key": "new"}}}}}
+		]}}`,
+		"/rest/api/3/issue/GCP-B": `{"key": "GCP-B", "fields": {"status": {"statusCategory": {"key": "new"}}, "issuelinks": [
+			{"id": "1", "type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"}, "inwardIssue": {"key": "GCP-A", "fields": {"status": {"statusCategory": {"key": "new"}}}}},
+			{"id": "2", "type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"}, "outwardIssue": {"key": "GCP-A", "fields": {"status": {"statusCategory": {"key": "new"}}}}}
+		]}}`,
+	})
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	graph, err := buildIssueGraph(context.Background(), client, "GCP-A", 3, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, n := range graph.Nodes {
+		if !n.Blocked {
+			t.Errorf("expected %s blocked", n.Key)
+		}
+	}
+	if len(graph.BlockedChains) != 1 {
+		t.Fatalf("expected 1 blocked chain, got %v", graph.BlockedChains)
+	}
+	if got := strings.Join(graph.BlockedChains[0], ","); got != "GCP-A,GCP-B" {
+		t.Errorf("expected chain A <- B, got %s", got)
+	}
+}
+
+func TestWriteGraphMermaid(t *testing.T) {
+	graph := &IssueGraph{
+		Root: "GCP-1",
+		Nodes: []GraphNode{
+			{Key: "GCP-1", Summary: `Say "hi"`},
+			{Key: "GCP-2", Summary: "Second", Blocked: true},
+		},
+		Edges: []GraphEdge{{From: "GCP-1", To: "GCP-2", Type: "Blocks", Label: "blocks"}},
+	}
+
+	var buf bytes.Buffer
+	writeGraphMermaid(&buf, graph)
+	out := buf.String()
+
+	for _, want := range []string{
+		"graph LR",
+		`GCP_1["GCP-1: Say #quot;hi#quot;"]`,
+		"GCP_1 -->|blocks| GCP_2",
+		"class GCP_2 blocked",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in output:\n%s", want, out)
+		}
+	}
+}
+
+func TestWriteGraphDOT(t *testing.T) {
+	graph := &IssueGraph{
+		Root: "GCP-1",
+		Nodes: []GraphNode{
+			{Key: "GCP-1", Summary: `Say "hi"`},
+			{Key: "GCP-2", Summary: "Second", Blocked: true},
+		},
+		Edges: []GraphEdge{{From: "GCP-1", To: "GCP-2", Type: "Blocks", Label: "blocks"}},
+	}
+
+	var buf bytes.Buffer
+	writeGraphDOT(&buf, graph)
+	out := buf.String()
+
+	for _, want := range []string{
+		"digraph issues {",
+		`"GCP-1" [label="GCP-1\nSay \"hi\""];`,
+		`"GCP-2" [label="GCP-2\nSecond", color=red];`,
+		`"GCP-1" -> "GCP-2" [label="blocks"];`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in output:\n%s", want, out)
+		}
+	}
+}
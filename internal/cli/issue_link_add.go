@@ -9,6 +9,7 @@ import (
 	"github.com/grantcarthew/ajira/internal/api"
 	"github.com/grantcarthew/ajira/internal/config"
 	"github.com/grantcarthew/ajira/internal/jira"
+	"github.com/grantcarthew/ajira/internal/journal"
 	"github.com/spf13/cobra"
 )
 
@@ -134,6 +135,11 @@ func createIssueLink(ctx context.Context, client *api.Client, outwardKey, inward
 		return fmt.Errorf("failed to marshal request: %w", err)
 	}
 
+	ctx = withUndo(ctx, &journal.Undo{
+		Kind:  journal.KindLink,
+		Issue: outwardKey,
+		Link:  &journal.Link{Type: linkTypeName, Outward: outwardKey, Inward: inwardKey},
+	})
 	_, err = client.Post(ctx, "/issueLink", body)
 	return err
 }
@@ -18,9 +18,10 @@ var issueLinkListCmd = &cobra.Command{
 	Long:  "List all issue links with direction, key, status, and summary.",
 	Example: `  ajira issue link list PROJ-123          # List all links
   ajira issue link list PROJ-123 --json  # JSON output`,
-	Args:         cobra.ExactArgs(1),
-	SilenceUsage: true,
-	RunE:         runIssueLinkList,
+	Args:              cobra.ExactArgs(1),
+	SilenceUsage:      true,
+	ValidArgsFunction: completeFirstArg(completeIssueKeys),
+	RunE:              runIssueLinkList,
 }
 
 func init() {
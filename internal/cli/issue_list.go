@@ -15,6 +15,9 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// issueListFields is the field list requested for IssueInfo results.
+const issueListFields = "summary,status,issuetype,priority,assignee"
+
 // IssueInfo represents a Jira issue for output.
 type IssueInfo struct {
 	Key            string `json:"key"`
@@ -44,9 +47,22 @@ type issueFields struct {
 	IssueType *issueType     `json:"issuetype"`
 	Priority  *priorityField `json:"priority"`
 	Assignee  *userField     `json:"assignee"`
+
+	// Raw holds every returned field by ID, for custom fields such as
+	// story points that have no fixed key.
+	Raw map[string]json.RawMessage `json:"-"`
+}
+
+func (f *issueFields) UnmarshalJSON(data []byte) error {
+	type plain issueFields
+	if err := json.Unmarshal(data, (*plain)(f)); err != nil {
+		return err
+	}
+	return json.Unmarshal(data, &f.Raw)
 }
 
 type statusField struct {
+	ID             string          `json:"id,omitempty"`
 	Name           string          `json:"name"`
 	StatusCategory *statusCategory `json:"statusCategory"`
 }
@@ -115,6 +131,13 @@ func init() {
 	issueListCmd.Flags().StringVar(&issueListSprint, "sprint", "", "Filter by sprint ID")
 	issueListCmd.Flags().StringVar(&issueListEpic, "epic", "", "Filter by epic key")
 
+	_ = issueListCmd.RegisterFlagCompletionFunc("status", completeStatuses)
+	_ = issueListCmd.RegisterFlagCompletionFunc("type", completeIssueTypes)
+	_ = issueListCmd.RegisterFlagCompletionFunc("assignee", completeUsers)
+	_ = issueListCmd.RegisterFlagCompletionFunc("reporter", completeUsers)
+	_ = issueListCmd.RegisterFlagCompletionFunc("priority", completePriorities)
+	_ = issueListCmd.RegisterFlagCompletionFunc("sprint", completeSprints)
+
 	issueCmd.AddCommand(issueListCmd)
 }
 
@@ -170,61 +193,7 @@ func runIssueList(cmd *cobra.Command, args []string) error {
 		}
 		fmt.Println(string(output))
 	} else {
-		if len(issues) == 0 {
-			fmt.Println("No issues found.")
-			return nil
-		}
-
-		bold := color.New(color.Bold).SprintFunc()
-		faint := color.New(color.Faint).SprintFunc()
-		header := color.New(color.FgCyan, color.Bold).SprintFunc()
-
-		// Calculate column widths using display width for Unicode support
-		keyWidth, statusWidth, typeWidth, assigneeWidth := 8, 11, 4, 8
-		for _, issue := range issues {
-			if w := width.StringWidth(issue.Key); w > keyWidth {
-				keyWidth = w
-			}
-			if w := width.StringWidth(issue.Status); w > statusWidth {
-				statusWidth = w
-			}
-			if w := width.StringWidth(issue.Type); w > typeWidth {
-				typeWidth = w
-			}
-			assignee := issue.Assignee
-			if assignee == "" {
-				assignee = "-"
-			}
-			if w := width.StringWidth(assignee); w > assigneeWidth {
-				assigneeWidth = w
-			}
-		}
-
-		// Print header
-		fmt.Printf("%s  %s  %s  %s  %s\n",
-			header(width.PadRight("KEY", keyWidth)),
-			header(width.PadRight("STATUS", statusWidth)),
-			header(width.PadRight("TYPE", typeWidth)),
-			header(width.PadRight("ASSIGNEE", assigneeWidth)),
-			header("SUMMARY"))
-
-		// Print rows
-		for _, issue := range issues {
-			key := bold(width.PadRight(issue.Key, keyWidth))
-			status := colorStatus(width.PadRight(issue.Status, statusWidth), issue.StatusCategory)
-
-			assignee := issue.Assignee
-			if assignee == "" {
-				assignee = faint(width.PadRight("-", assigneeWidth))
-			} else {
-				assignee = width.PadRight(assignee, assigneeWidth)
-			}
-
-			// Truncate summary for display using display width
-			summary := width.Truncate(issue.Summary, 60, "...")
-
-			fmt.Printf("%s  %s  %s  %s  %s\n", key, status, width.PadRight(issue.Type, typeWidth), assignee, summary)
-		}
+		printIssueList(issues)
 	}
 
 	return nil
@@ -284,7 +253,7 @@ func buildJQL() string {
 		conditions = append(conditions, fmt.Sprintf("sprint = \"%s\"", issueListSprint))
 	}
 	if issueListEpic != "" {
-		conditions = append(conditions, fmt.Sprintf("parent = \"%s\"", issueListEpic))
+		conditions = append(conditions, parentJQL(issueListEpic))
 	}
 
 	if len(conditions) == 0 {
@@ -297,6 +266,12 @@ func buildJQL() string {
 	return strings.Join(conditions, " AND ") + orderBy
 }
 
+// parentJQL returns the JQL condition matching direct children of an issue.
+// Works for epic children and subtasks alike.
+func parentJQL(key string) string {
+	return fmt.Sprintf("parent = \"%s\"", key)
+}
+
 // buildOrderBy constructs the ORDER BY clause based on flags.
 func buildOrderBy() string {
 	field := issueListOrderBy
@@ -313,18 +288,45 @@ func buildOrderBy() string {
 }
 
 func searchIssues(ctx context.Context, client *api.Client, jql string, limit int) ([]IssueInfo, error) {
-	var allIssues []IssueInfo
+	values, err := searchIssueValues(ctx, client, jql, issueListFields, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	issues := make([]IssueInfo, len(values))
+	for i, v := range values {
+		issues[i] = issueInfoFromValue(v)
+	}
+	return issues, nil
+}
+
+// searchIssueValues runs a JQL search requesting the given comma-separated
+// fields and returns the raw issue values. A limit of 0 returns all matches.
+func searchIssueValues(ctx context.Context, client *api.Client, jql, fields string, limit int) ([]issueValue, error) {
+	return searchIssuePages(ctx, client, jql, fields, limit, 100) // Safety guard against infinite pagination loops
+}
+
+// searchAllIssueValues is searchIssueValues without the page cap, for bulk
+// changes that must see every match. It stops if a page token repeats.
+func searchAllIssueValues(ctx context.Context, client *api.Client, jql, fields string) ([]issueValue, error) {
+	return searchIssuePages(ctx, client, jql, fields, 0, 0)
+}
+
+// searchIssuePages pages through a JQL search, reading at most maxPages
+// pages (0 = no cap).
+func searchIssuePages(ctx context.Context, client *api.Client, jql, fields string, limit, maxPages int) ([]issueValue, error) {
+	var allIssues []issueValue
 	maxResults := 50
 	if limit > 0 && limit < maxResults {
 		maxResults = limit
 	}
 
 	nextPageToken := ""
-	const maxPages = 100 // Safety guard against infinite pagination loops
+	seenTokens := make(map[string]bool)
 
-	for range maxPages {
-		path := fmt.Sprintf("/search/jql?jql=%s&maxResults=%d&fields=summary,status,issuetype,priority,assignee",
-			url.QueryEscape(jql), maxResults)
+	for page := 0; maxPages == 0 || page < maxPages; page++ {
+		path := fmt.Sprintf("/search/jql?jql=%s&maxResults=%d&fields=%s",
+			url.QueryEscape(jql), maxResults, fields)
 		if nextPageToken != "" {
 			path += "&nextPageToken=" + url.QueryEscape(nextPageToken)
 		}
@@ -340,27 +342,7 @@ func searchIssues(ctx context.Context, client *api.Client, jql string, limit int
 		}
 
 		for _, issue := range resp.Issues {
-			info := IssueInfo{
-				Key:     issue.Key,
-				Summary: issue.Fields.Summary,
-			}
-			if issue.Fields.Status != nil {
-				info.Status = issue.Fields.Status.Name
-				if issue.Fields.Status.StatusCategory != nil {
-					info.StatusCategory = issue.Fields.Status.StatusCategory.Key
-				}
-			}
-			if issue.Fields.IssueType != nil {
-				info.Type = issue.Fields.IssueType.Name
-			}
-			if issue.Fields.Priority != nil {
-				info.Priority = issue.Fields.Priority.Name
-			}
-			if issue.Fields.Assignee != nil {
-				info.Assignee = issue.Fields.Assignee.DisplayName
-			}
-
-			allIssues = append(allIssues, info)
+			allIssues = append(allIssues, issue)
 
 			if limit > 0 && len(allIssues) >= limit {
 				return allIssues[:limit], nil
@@ -370,6 +352,13 @@ func searchIssues(ctx context.Context, client *api.Client, jql string, limit int
 		if resp.IsLast || resp.NextPageToken == "" {
 			break
 		}
+		// Without a page cap, a repeated token is the only loop guard
+		if maxPages == 0 {
+			if seenTokens[resp.NextPageToken] {
+				break
+			}
+			seenTokens[resp.NextPageToken] = true
+		}
 
 		nextPageToken = resp.NextPageToken
 	}
@@ -377,6 +366,90 @@ func searchIssues(ctx context.Context, client *api.Client, jql string, limit int
 	return allIssues, nil
 }
 
+// printIssueList renders issues as a table with key, status, type, assignee,
+// and summary columns.
+func printIssueList(issues []IssueInfo) {
+	if len(issues) == 0 {
+		fmt.Println("No issues found.")
+		return
+	}
+
+	bold := color.New(color.Bold).SprintFunc()
+	faint := color.New(color.Faint).SprintFunc()
+	header := color.New(color.FgCyan, color.Bold).SprintFunc()
+
+	// Calculate column widths using display width for Unicode support
+	keyWidth, statusWidth, typeWidth, assigneeWidth := 8, 11, 4, 8
+	for _, issue := range issues {
+		if w := width.StringWidth(issue.Key); w > keyWidth {
+			keyWidth = w
+		}
+		if w := width.StringWidth(issue.Status); w > statusWidth {
+			statusWidth = w
+		}
+		if w := width.StringWidth(issue.Type); w > typeWidth {
+			typeWidth = w
+		}
+		assignee := issue.Assignee
+		if assignee == "" {
+			assignee = "-"
+		}
+		if w := width.StringWidth(assignee); w > assigneeWidth {
+			assigneeWidth = w
+		}
+	}
+
+	// Print header
+	fmt.Printf("%s  %s  %s  %s  %s\n",
+		header(width.PadRight("KEY", keyWidth)),
+		header(width.PadRight("STATUS", statusWidth)),
+		header(width.PadRight("TYPE", typeWidth)),
+		header(width.PadRight("ASSIGNEE", assigneeWidth)),
+		header("SUMMARY"))
+
+	// Print rows
+	for _, issue := range issues {
+		key := bold(width.PadRight(issue.Key, keyWidth))
+		status := colorStatus(width.PadRight(issue.Status, statusWidth), issue.StatusCategory)
+
+		assignee := issue.Assignee
+		if assignee == "" {
+			assignee = faint(width.PadRight("-", assigneeWidth))
+		} else {
+			assignee = width.PadRight(assignee, assigneeWidth)
+		}
+
+		// Truncate summary for display using display width
+		summary := width.Truncate(issue.Summary, 60, "...")
+
+		fmt.Printf("%s  %s  %s  %s  %s\n", key, status, width.PadRight(issue.Type, typeWidth), assignee, summary)
+	}
+}
+
+// issueInfoFromValue flattens a search result issue into IssueInfo.
+func issueInfoFromValue(issue issueValue) IssueInfo {
+	info := IssueInfo{
+		Key:     issue.Key,
+		Summary: issue.Fields.Summary,
+	}
+	if issue.Fields.Status != nil {
+		info.Status = issue.Fields.Status.Name
+		if issue.Fields.Status.StatusCategory != nil {
+			info.StatusCategory = issue.Fields.Status.StatusCategory.Key
+		}
+	}
+	if issue.Fields.IssueType != nil {
+		info.Type = issue.Fields.IssueType.Name
+	}
+	if issue.Fields.Priority != nil {
+		info.Priority = issue.Fields.Priority.Name
+	}
+	if issue.Fields.Assignee != nil {
+		info.Assignee = issue.Fields.Assignee.DisplayName
+	}
+	return info
+}
+
 // colorStatus returns a colored status string based on status category.
 func colorStatus(status, category string) string {
 	green := color.New(color.FgGreen).SprintFunc()
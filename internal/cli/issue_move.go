@@ -9,6 +9,7 @@ import (
 	"github.com/grantcarthew/ajira/internal/api"
 	"github.com/grantcarthew/ajira/internal/config"
 	"github.com/grantcarthew/ajira/internal/converter"
+	"github.com/grantcarthew/ajira/internal/journal"
 	"github.com/spf13/cobra"
 )
 
@@ -44,24 +45,30 @@ var (
 	moveResolution      string
 	moveAssignee        string
 	moveStdin           bool
+	moveJQL             string
+	moveYes             bool
 )
 
 var issueMoveCmd = &cobra.Command{
 	Use:     "move <issue-key> [status]",
 	Aliases: []string{"mv", "transition"},
 	Short:   "Move issue",
-	Long:    "Transition an issue to a new status. Supports -m comment, -R resolution, -a assignee, --stdin for batch.",
+	Long:    "Transition an issue to a new status. Supports -m comment, -R resolution, -a assignee, --stdin or --jql for batch.",
 	Example: `  ajira issue move PROJ-123                              # List available transitions
   ajira issue move PROJ-123 "In Progress"                # Move to In Progress
   ajira issue move PROJ-123 Done                         # Move to Done
   ajira issue move PROJ-123 Done -m "Completed work"     # Move with comment
   ajira issue move PROJ-123 Done -R Done                 # Move with resolution
   ajira issue move PROJ-123 "In Progress" -a me          # Move and assign
-  echo -e "PROJ-1\nPROJ-2" | ajira issue move --stdin Done  # Batch move`,
+  echo -e "PROJ-1\nPROJ-2" | ajira issue move --stdin Done  # Batch move
+  ajira issue move --jql "sprint in closedSprints() AND status = Review" Done --dry-run`,
 	Args: func(cmd *cobra.Command, args []string) error {
-		if moveStdin {
+		if moveStdin && moveJQL != "" {
+			return fmt.Errorf("cannot use --stdin with --jql")
+		}
+		if moveStdin || moveJQL != "" {
 			if len(args) != 1 {
-				return fmt.Errorf("with --stdin, requires exactly 1 argument: <status>")
+				return fmt.Errorf("with --stdin or --jql, requires exactly 1 argument: <status>")
 			}
 		} else {
 			if len(args) < 1 || len(args) > 2 {
@@ -70,8 +77,9 @@ var issueMoveCmd = &cobra.Command{
 		}
 		return nil
 	},
-	SilenceUsage: true,
-	RunE:         runIssueMove,
+	SilenceUsage:      true,
+	ValidArgsFunction: completeIssueMove,
+	RunE:              runIssueMove,
 }
 
 func init() {
@@ -80,10 +88,25 @@ func init() {
 	issueMoveCmd.Flags().StringVarP(&moveResolution, "resolution", "R", "", "Set resolution (e.g., Done, Won't Do)")
 	issueMoveCmd.Flags().StringVarP(&moveAssignee, "assignee", "a", "", "Set assignee (email, accountId, me)")
 	issueMoveCmd.Flags().BoolVar(&moveStdin, "stdin", false, "Read issue keys from stdin (one per line)")
+	issueMoveCmd.Flags().StringVar(&moveJQL, "jql", "", "Move all issues matching a JQL query")
+	issueMoveCmd.Flags().BoolVar(&moveYes, "yes", false, "Confirm changes to more than 50 issues matched by --jql")
+
+	_ = issueMoveCmd.RegisterFlagCompletionFunc("assignee", completeUsers)
 
 	issueCmd.AddCommand(issueMoveCmd)
 }
 
+// completeIssueMove completes the issue key, then the statuses it can move to.
+func completeIssueMove(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	switch len(args) {
+	case 0:
+		return completeIssueKeys(cmd, args, toComplete)
+	case 1:
+		return completeTransitions(cmd, args[0])
+	}
+	return nil, cobra.ShellCompDirectiveNoFileComp
+}
+
 func runIssueMove(cmd *cobra.Command, args []string) error {
 	ctx := cmd.Context()
 
@@ -98,6 +121,9 @@ func runIssueMove(cmd *cobra.Command, args []string) error {
 	if moveStdin {
 		return runIssueMoveStdin(ctx, client, cfg, args[0])
 	}
+	if moveJQL != "" {
+		return runIssueMoveJQL(ctx, client, cfg, args[0])
+	}
 
 	issueKey := args[0]
 
@@ -160,7 +186,13 @@ func runIssueMove(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	err = doTransition(ctx, client, issueKey, matchedTransition.ID, fields, update)
+	moveCtx, err := undoContext(ctx, client, journal.KindTransition, issueKey, moveFieldIDs(fields), nil)
+	if err != nil {
+		return err
+	}
+
+	moveCtx = api.WithTransition(moveCtx, matchedTransition.Name, matchedTransition.To.Name)
+	err = doTransition(moveCtx, client, issueKey, matchedTransition.ID, fields, update)
 	if err != nil {
 		return err
 	}
@@ -182,6 +214,9 @@ func runIssueMoveStdin(ctx context.Context, client *api.Client, cfg *config.Conf
 	if err != nil {
 		return err
 	}
+	if err := checkBatchPolicy(len(issueKeys)); err != nil {
+		return err
+	}
 	if len(issueKeys) == 0 {
 		return fmt.Errorf("no issue keys provided via stdin")
 	}
@@ -202,12 +237,68 @@ func runIssueMoveStdin(ctx context.Context, client *api.Client, cfg *config.Conf
 		return nil
 	}
 
+	return PrintBatchResults(moveIssues(ctx, client, issueKeys, targetStatus, fields, update))
+}
+
+// runIssueMoveJQL moves every issue matching --jql, or previews the status,
+// assignee, and resolution changes under --dry-run.
+func runIssueMoveJQL(ctx context.Context, client *api.Client, cfg *config.Config, targetStatus string) error {
+	issues, err := resolveJQLIssues(ctx, client, moveJQL, "summary,status,assignee,resolution", moveYes)
+	if err != nil {
+		return err
+	}
+
+	fields, update, err := buildTransitionOptions(ctx, client, cfg)
+	if err != nil {
+		return err
+	}
+
+	if DryRun() {
+		var previews []IssuePreview
+		for _, issue := range issues {
+			changes := moveChanges(issue, targetStatus, fields)
+			if len(changes) > 0 {
+				previews = append(previews, IssuePreview{Key: issue.Key, Summary: issue.Fields.Summary, Changes: changes})
+			}
+		}
+		printIssuePreviews(previews, len(issues))
+		return nil
+	}
+
+	return PrintBatchResults(moveIssues(ctx, client, issueValueKeys(issues), targetStatus, fields, update))
+}
+
+// moveChanges returns the field changes moving an issue to targetStatus
+// with the move flags would make. The assignee is compared by the account
+// ID resolved into fields.
+func moveChanges(issue issueValue, targetStatus string, fields map[string]any) []FieldChange {
+	var changes []FieldChange
+	status := ""
+	if issue.Fields.Status != nil {
+		status = issue.Fields.Status.Name
+	}
+	if !strings.EqualFold(status, targetStatus) {
+		changes = append(changes, FieldChange{Field: "status", From: status, To: targetStatus})
+	}
+	if assignee, ok := fields["assignee"].(map[string]string); ok {
+		accountID := assignee["accountId"]
+		changes = append(changes, assignChanges(issue, &accountID, moveAssignee)...)
+	}
+	if moveResolution != "" {
+		changes = appendFieldChange(changes, "resolution", fieldText(issue.Fields.Raw["resolution"]), moveResolution)
+	}
+	return changes
+}
+
+// moveIssues transitions each issue to targetStatus and returns the result
+// for each key.
+func moveIssues(ctx context.Context, client *api.Client, issueKeys []string, targetStatus string, fields, update map[string]any) []BatchResult {
 	var results []BatchResult
 	for _, key := range issueKeys {
 		// Get transitions for this specific issue
 		transitions, err := getTransitions(ctx, client, key)
 		if err != nil {
-			results = append(results, BatchResult{Key: key, Success: false, Error: err.Error()})
+			results = append(results, failedResult(key, err))
 			continue
 		}
 
@@ -218,15 +309,28 @@ func runIssueMoveStdin(ctx context.Context, client *api.Client, cfg *config.Conf
 			continue
 		}
 
-		err = doTransition(ctx, client, key, matchedTransition.ID, fields, update)
+		moveCtx, err := undoContext(ctx, client, journal.KindTransition, key, moveFieldIDs(fields), nil)
+		if err == nil {
+			moveCtx = api.WithTransition(moveCtx, matchedTransition.Name, matchedTransition.To.Name)
+			err = doTransition(moveCtx, client, key, matchedTransition.ID, fields, update)
+		}
 		if err != nil {
-			results = append(results, BatchResult{Key: key, Success: false, Error: err.Error()})
+			results = append(results, failedResult(key, err))
 		} else {
 			results = append(results, BatchResult{Key: key, Success: true})
 		}
 	}
+	return results
+}
 
-	return PrintBatchResults(results)
+// moveFieldIDs returns the IDs of the fields journaled before a move: the
+// status, and the assignee if the move changes it.
+func moveFieldIDs(fields map[string]any) []string {
+	ids := []string{"status"}
+	if _, ok := fields["assignee"]; ok {
+		ids = append(ids, "assignee")
+	}
+	return ids
 }
 
 func buildTransitionOptions(ctx context.Context, client *api.Client, cfg *config.Config) (map[string]any, map[string]any, error) {
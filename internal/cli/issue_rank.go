@@ -0,0 +1,257 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/grantcarthew/ajira/internal/api"
+	"github.com/grantcarthew/ajira/internal/config"
+	"github.com/spf13/cobra"
+)
+
+// agileBatchSize is the maximum number of issues the Agile API accepts per
+// rank or move call.
+const agileBatchSize = 50
+
+// rankRequest matches the Jira Agile API request for ranking issues.
+type rankRequest struct {
+	Issues          []string `json:"issues"`
+	RankBeforeIssue string   `json:"rankBeforeIssue,omitempty"`
+	RankAfterIssue  string   `json:"rankAfterIssue,omitempty"`
+}
+
+// rankResponse matches the 207 multi-status response of the rank API.
+type rankResponse struct {
+	Entries []rankEntry `json:"entries"`
+}
+
+type rankEntry struct {
+	IssueKey string   `json:"issueKey"`
+	Status   int      `json:"status"`
+	Errors   []string `json:"errors,omitempty"`
+}
+
+var (
+	rankBefore string
+	rankAfter  string
+	rankTop    bool
+	rankStdin  bool
+)
+
+var issueRankCmd = &cobra.Command{
+	Use:   "rank <issue-keys...>",
+	Short: "Rank issues",
+	Long:  "Reorder issues relative to another issue. Keys keep their given order. Use --top to move issues to the top of the board backlog (requires --board or JIRA_BOARD).",
+	Example: `  ajira issue rank GCP-5 --before GCP-1          # Move GCP-5 above GCP-1
+  ajira issue rank GCP-5 GCP-6 --after GCP-1     # Place GCP-5, GCP-6 below GCP-1
+  ajira issue rank GCP-9 --top                   # Top of the backlog
+  cat priorities.txt | ajira issue rank --stdin --top`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if rankStdin {
+			if len(args) != 0 {
+				return fmt.Errorf("with --stdin, no arguments should be provided")
+			}
+		} else if len(args) < 1 {
+			return fmt.Errorf("requires at least 1 argument: <issue-keys...>")
+		}
+		return nil
+	},
+	SilenceUsage: true,
+	RunE:         runIssueRank,
+}
+
+func init() {
+	issueRankCmd.Flags().StringVar(&rankBefore, "before", "", "Rank issues before this issue")
+	issueRankCmd.Flags().StringVar(&rankAfter, "after", "", "Rank issues after this issue")
+	issueRankCmd.Flags().BoolVar(&rankTop, "top", false, "Rank issues at the top of the board backlog")
+	issueRankCmd.Flags().BoolVar(&rankStdin, "stdin", false, "Read issue keys from stdin (one per line)")
+
+	issueCmd.AddCommand(issueRankCmd)
+}
+
+func runIssueRank(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	var issueKeys []string
+	var err error
+
+	if rankStdin {
+		issueKeys, err = ReadKeysFromStdin()
+		if err != nil {
+			return err
+		}
+		if err := checkBatchPolicy(len(issueKeys)); err != nil {
+			return err
+		}
+		if len(issueKeys) == 0 {
+			return fmt.Errorf("no issue keys provided via stdin")
+		}
+	} else {
+		issueKeys = args
+	}
+
+	targets := 0
+	for _, set := range []bool{rankBefore != "", rankAfter != "", rankTop} {
+		if set {
+			targets++
+		}
+	}
+	if targets != 1 {
+		return fmt.Errorf("specify exactly one of --before, --after, or --top")
+	}
+	if rankTop && Board() == "" {
+		return fmt.Errorf("--top requires a board; use --board flag or set JIRA_BOARD")
+	}
+
+	anchor, after := rankBefore, false
+	if rankAfter != "" {
+		anchor, after = rankAfter, true
+	}
+	if anchor != "" && slices.Contains(issueKeys, anchor) {
+		return fmt.Errorf("cannot rank %s relative to itself", anchor)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(cfg)
+
+	if rankTop {
+		anchor, err = backlogTopAnchor(ctx, client, Board(), issueKeys)
+		if err != nil {
+			if apiErr, ok := err.(*api.APIError); ok {
+				return fmt.Errorf("API error: %w", apiErr)
+			}
+			return err
+		}
+	}
+
+	action := fmt.Sprintf("rank before %s", anchor)
+	if after {
+		action = fmt.Sprintf("rank after %s", anchor)
+	}
+
+	// Dry-run mode
+	if DryRun() {
+		if len(issueKeys) == 1 {
+			PrintDryRun(fmt.Sprintf("%s %s", action, issueKeys[0]))
+		} else {
+			PrintDryRunBatch(issueKeys, action)
+		}
+		return nil
+	}
+
+	results := rankIssues(ctx, client, issueKeys, anchor, after)
+
+	if len(results) == 1 {
+		if !results[0].Success {
+			return fmt.Errorf("failed to rank %s: %s", results[0].Key, results[0].Error)
+		}
+		if JSONOutput() {
+			PrintSuccessJSON(map[string]string{"key": issueKeys[0], "action": action})
+		} else {
+			PrintSuccess(fmt.Sprintf("Ranked %s %s", issueKeys[0], strings.TrimPrefix(action, "rank ")))
+		}
+		return nil
+	}
+
+	return PrintBatchResults(results)
+}
+
+// backlogTopAnchor returns the highest-ranked backlog issue that is not one of
+// the keys being ranked, so they can be placed before it.
+func backlogTopAnchor(ctx context.Context, client *api.Client, boardID string, issueKeys []string) (string, error) {
+	values, err := fetchAgileIssues(ctx, client, fmt.Sprintf("/board/%s/backlog", url.PathEscape(boardID)), issueListFields, len(issueKeys)+1)
+	if err != nil {
+		return "", fmt.Errorf("failed to read backlog: %w", err)
+	}
+
+	for _, v := range values {
+		if !slices.Contains(issueKeys, v.Key) {
+			return v.Key, nil
+		}
+	}
+
+	return "", fmt.Errorf("backlog for board %s has no other issues to rank against", boardID)
+}
+
+// rankIssues ranks issueKeys relative to anchor in batches of agileBatchSize.
+// The first batch is placed before or after the anchor; each following batch
+// is placed after the last key of the previous batch so the given order is
+// preserved across batches.
+func rankIssues(ctx context.Context, client *api.Client, issueKeys []string, anchor string, after bool) []BatchResult {
+	var results []BatchResult
+
+	for batch := range slices.Chunk(issueKeys, agileBatchSize) {
+		req := rankRequest{Issues: batch}
+		if after {
+			req.RankAfterIssue = anchor
+		} else {
+			req.RankBeforeIssue = anchor
+		}
+
+		results = append(results, rankBatch(ctx, client, req)...)
+
+		anchor, after = batch[len(batch)-1], true
+	}
+
+	return results
+}
+
+func rankBatch(ctx context.Context, client *api.Client, req rankRequest) []BatchResult {
+	results := make([]BatchResult, len(req.Issues))
+	for i, key := range req.Issues {
+		results[i] = BatchResult{Key: key, Success: true}
+	}
+
+	fail := func(err error) []BatchResult {
+		for i := range results {
+			results[i] = failedResult(results[i].Key, err)
+		}
+		return results
+	}
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return fail(fmt.Errorf("failed to marshal request: %w", err))
+	}
+
+	respBody, err := client.AgilePut(ctx, "/issue/rank", body)
+	if err != nil {
+		return fail(err)
+	}
+
+	// 204 No Content means every issue was ranked; a 207 lists per-issue status.
+	if len(respBody) == 0 {
+		return results
+	}
+
+	var resp rankResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return fail(fmt.Errorf("failed to parse response: %w", err))
+	}
+
+	failed := make(map[string]string)
+	for _, e := range resp.Entries {
+		if e.Status >= 400 {
+			msg := strings.Join(e.Errors, "; ")
+			if msg == "" {
+				msg = fmt.Sprintf("status %d", e.Status)
+			}
+			failed[e.IssueKey] = msg
+		}
+	}
+	for i, r := range results {
+		if msg, ok := failed[r.Key]; ok {
+			results[i] = BatchResult{Key: r.Key, Success: false, Error: msg}
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,117 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grantcarthew/ajira/internal/api"
+)
+
+func TestRankIssues_ChainsBatches(t *testing.T) {
+	var requests []rankRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/rest/agile/1.0/issue/rank" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		body, _ := io.ReadAll(r.Body)
+		var req rankRequest
+		_ = json.Unmarshal(body, &req)
+		requests = append(requests, req)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	keys := make([]string, 120)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("GCP-%d", i+1)
+	}
+
+	client := api.NewClient(testConfig(server.URL))
+	results := rankIssues(context.Background(), client, keys, "GCP-999", false)
+
+	if len(results) != 120 {
+		t.Fatalf("expected 120 results, got %d", len(results))
+	}
+	if len(requests) != 3 {
+		t.Fatalf("expected 3 batches, got %d", len(requests))
+	}
+	if requests[0].RankBeforeIssue != "GCP-999" || len(requests[0].Issues) != 50 {
+		t.Errorf("unexpected first batch: before=%s size=%d", requests[0].RankBeforeIssue, len(requests[0].Issues))
+	}
+	if requests[1].RankAfterIssue != "GCP-50" || requests[1].RankBeforeIssue != "" {
+		t.Errorf("expected second batch after GCP-50, got %+v", requests[1])
+	}
+	if requests[2].RankAfterIssue != "GCP-100" || len(requests[2].Issues) != 20 {
+		t.Errorf("expected third batch of 20 after GCP-100, got after=%s size=%d", requests[2].RankAfterIssue, len(requests[2].Issues))
+	}
+}
+
+func TestRankIssues_PartialFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusMultiStatus)
+		_ = json.NewEncoder(w).Encode(rankResponse{Entries: []rankEntry{
+			{IssueKey: "GCP-1", Status: 200},
+			{IssueKey: "GCP-2", Status: 404, Errors: []string{"Issue does not exist"}},
+		}})
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	results := rankIssues(context.Background(), client, []string{"GCP-1", "GCP-2"}, "GCP-10", true)
+
+	if !results[0].Success {
+		t.Errorf("expected GCP-1 success, got %+v", results[0])
+	}
+	if results[1].Success || results[1].Error != "Issue does not exist" {
+		t.Errorf("expected GCP-2 failure, got %+v", results[1])
+	}
+}
+
+func TestRankIssues_APIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"errorMessages":["Rank field missing"]}`))
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	results := rankIssues(context.Background(), client, []string{"GCP-1", "GCP-2"}, "GCP-10", false)
+
+	for _, r := range results {
+		if r.Success {
+			t.Errorf("expected failure for %s", r.Key)
+		}
+	}
+}
+
+func TestBacklogTopAnchor_SkipsRankedKeys(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/agile/1.0/board/1342/backlog" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("maxResults") != "3" {
+			t.Errorf("expected maxResults=3, got %s", r.URL.Query().Get("maxResults"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(agileIssueResponse{
+			Total:  10,
+			Issues: []issueValue{{Key: "GCP-7"}, {Key: "GCP-3"}, {Key: "GCP-4"}},
+		})
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	anchor, err := backlogTopAnchor(context.Background(), client, "1342", []string{"GCP-7", "GCP-8"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if anchor != "GCP-3" {
+		t.Errorf("expected anchor GCP-3, got %s", anchor)
+	}
+}
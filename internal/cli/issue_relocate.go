@@ -0,0 +1,574 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grantcarthew/ajira/internal/api"
+	"github.com/grantcarthew/ajira/internal/config"
+	"github.com/grantcarthew/ajira/internal/jira"
+	"github.com/spf13/cobra"
+)
+
+// RelocatePlan describes where an issue moves. ToStatus is empty when Jira
+// picks the default status of the target workflow.
+type RelocatePlan struct {
+	Key        string `json:"key"`
+	Project    string `json:"project"`
+	IssueType  string `json:"issueType"`
+	FromStatus string `json:"fromStatus"`
+	ToStatus   string `json:"toStatus,omitempty"`
+}
+
+// relocateIssue is an issue to move with its current project, type, and status.
+type relocateIssue struct {
+	ID          string
+	Key         string
+	Project     string
+	IssueTypeID string
+	IssueType   string
+	StatusID    string
+	Status      string
+}
+
+// relocateSearchResponse matches the search API response for issues to move.
+type relocateSearchResponse struct {
+	Issues []struct {
+		ID     string `json:"id"`
+		Key    string `json:"key"`
+		Fields struct {
+			Project   projectField `json:"project"`
+			IssueType struct {
+				ID   string `json:"id"`
+				Name string `json:"name"`
+			} `json:"issuetype"`
+			Status struct {
+				ID   string `json:"id"`
+				Name string `json:"name"`
+			} `json:"status"`
+		} `json:"fields"`
+	} `json:"issues"`
+}
+
+// bulkMoveRequest is the request body of the bulk move API. Targets are keyed
+// by "<project>,<issueTypeId>".
+type bulkMoveRequest struct {
+	SendBulkNotification   bool                       `json:"sendBulkNotification"`
+	TargetToSourcesMapping map[string]*bulkMoveTarget `json:"targetToSourcesMapping"`
+}
+
+type bulkMoveTarget struct {
+	IssueIdsOrKeys              []string         `json:"issueIdsOrKeys"`
+	InferClassificationDefaults bool             `json:"inferClassificationDefaults"`
+	InferFieldDefaults          bool             `json:"inferFieldDefaults"`
+	InferStatusDefaults         bool             `json:"inferStatusDefaults"`
+	InferSubtaskTypeDefault     bool             `json:"inferSubtaskTypeDefault"`
+	TargetStatus                []bulkMoveStatus `json:"targetStatus,omitempty"`
+}
+
+// bulkMoveStatus maps target status IDs to the source statuses moving into
+// them. Unmapped statuses fall back to the target workflow default.
+type bulkMoveStatus struct {
+	Statuses map[string][]bulkMoveSourceStatus `json:"statuses"`
+}
+
+type bulkMoveSourceStatus struct {
+	StatusID string `json:"statusId"`
+}
+
+// bulkTaskResponse matches the bulk operation progress API response.
+// Processed issues are reported by ID; failures map issue IDs to messages.
+type bulkTaskResponse struct {
+	TaskID                    string              `json:"taskId"`
+	Status                    string              `json:"status"`
+	ProgressPercent           int                 `json:"progressPercent"`
+	ProcessedAccessibleIssues []int64             `json:"processedAccessibleIssues"`
+	FailedAccessibleIssues    map[string][]string `json:"failedAccessibleIssues"`
+}
+
+// relocation is a planned bulk move, split into batches of at most
+// bulkMoveBatchSize issues. Results holds issues decided without moving: not
+// found, no matching type, or already in place.
+type relocation struct {
+	Batches []*relocateBatch
+	Plans   []RelocatePlan
+	Moving  []relocateIssue
+	Results map[string]BatchResult
+}
+
+// relocateBatch is one bulk move request and the issues it moves.
+type relocateBatch struct {
+	Targets map[string]*bulkMoveTarget
+	Moving  []relocateIssue
+}
+
+// bulkMoveBatchSize is the maximum number of issues the bulk move API
+// accepts per request.
+const bulkMoveBatchSize = 1000
+
+var (
+	relocateProject   string
+	relocateType      string
+	relocateStatusMap []string
+	relocateNoNotify  bool
+	relocateStdin     bool
+	relocateTimeout   time.Duration
+)
+
+// relocatePollInterval is how often the bulk move task is polled.
+var relocatePollInterval = 2 * time.Second
+
+var issueRelocateCmd = &cobra.Command{
+	Use:   "relocate <issue-keys...>",
+	Short: "Move issues to another project or type",
+	Long: `Move issues to another project, issue type, or both, using the bulk move API.
+
+Statuses with the same name in the target workflow are kept; map others with --status-map
+"<from>=<to>". Unmapped statuses, required fields, and subtask types take the target defaults.
+Waits for the move to finish and reports the result for each issue. More than 1000 issues are
+moved in several tasks, one after another. If the move has not finished within --timeout, the
+task ID is reported; the move continues in Jira.`,
+	Example: `  ajira issue relocate PROJ-1 PROJ-2 --to-project NEW          # Keep types and statuses
+  ajira issue relocate PROJ-1 --type Bug                        # Change type in place
+  ajira issue relocate PROJ-1 --to-project NEW -t Story --status-map "Doing=In Progress"
+  cat keys.txt | ajira issue relocate --stdin --to-project BILL  # Batch from stdin
+  ajira issue relocate PROJ-1 PROJ-2 --to-project NEW --dry-run  # Show the plan`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if relocateStdin {
+			if len(args) != 0 {
+				return fmt.Errorf("with --stdin, no arguments are accepted")
+			}
+		} else if len(args) == 0 {
+			return fmt.Errorf("requires at least 1 argument: <issue-keys...>")
+		}
+		return nil
+	},
+	SilenceUsage:      true,
+	ValidArgsFunction: completeIssueKeys,
+	RunE:              runIssueRelocate,
+}
+
+func init() {
+	issueRelocateCmd.Flags().StringVar(&relocateProject, "to-project", "", "Target project key (default: current project of each issue)")
+	issueRelocateCmd.Flags().StringVarP(&relocateType, "type", "t", "", "Target issue type (default: current type of each issue)")
+	issueRelocateCmd.Flags().StringSliceVar(&relocateStatusMap, "status-map", nil, "Map source to target status: <from>=<to> (repeatable or comma-separated)")
+	issueRelocateCmd.Flags().BoolVar(&relocateNoNotify, "no-notify", false, "Do not send bulk change notifications")
+	issueRelocateCmd.Flags().BoolVar(&relocateStdin, "stdin", false, "Read issue keys from stdin (one per line)")
+	issueRelocateCmd.Flags().DurationVar(&relocateTimeout, "timeout", 30*time.Minute, "Maximum time to wait for the move to finish")
+
+	_ = issueRelocateCmd.RegisterFlagCompletionFunc("to-project", completeProjects)
+	_ = issueRelocateCmd.RegisterFlagCompletionFunc("type", completeIssueTypes)
+
+	issueCmd.AddCommand(issueRelocateCmd)
+}
+
+func runIssueRelocate(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	if relocateProject == "" && relocateType == "" {
+		return fmt.Errorf("--to-project or --type is required")
+	}
+
+	statusMap, err := parseStatusMap(relocateStatusMap)
+	if err != nil {
+		return err
+	}
+
+	keys := args
+	if relocateStdin {
+		keys, err = ReadKeysFromStdin()
+		if err != nil {
+			return err
+		}
+		if len(keys) == 0 {
+			return fmt.Errorf("no issue keys provided via stdin")
+		}
+	}
+	if err := checkBatchPolicy(len(keys)); err != nil {
+		return err
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(cfg)
+
+	issues, err := fetchRelocateIssues(ctx, client, keys)
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); ok {
+			return fmt.Errorf("API error: %w", apiErr)
+		}
+		return fmt.Errorf("failed to fetch issues: %w", err)
+	}
+
+	plan, err := planRelocation(ctx, client, keys, issues, relocateProject, relocateType, statusMap)
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); ok {
+			return fmt.Errorf("API error: %w", apiErr)
+		}
+		return err
+	}
+
+	if DryRun() {
+		printRelocatePlans(plan.Plans)
+		return nil
+	}
+
+	var progress io.Writer
+	if !JSONOutput() && !Quiet() {
+		progress = cmd.ErrOrStderr()
+	}
+
+	deadline := time.Now().Add(relocateTimeout)
+	for i, batch := range plan.Batches {
+		err := runRelocateBatch(ctx, client, batch, deadline, plan.Results, progress)
+		if err == nil {
+			continue
+		}
+		// Nothing has moved yet, so report the error as it is
+		if i == 0 {
+			if apiErr, ok := err.(*api.APIError); ok {
+				return fmt.Errorf("API error: %w", apiErr)
+			}
+			return err
+		}
+		for _, issue := range batch.Moving {
+			plan.Results[issue.Key] = failedResult(issue.Key, err)
+		}
+		for _, rest := range plan.Batches[i+1:] {
+			for _, issue := range rest.Moving {
+				plan.Results[issue.Key] = failedResult(issue.Key, fmt.Errorf("not moved: stopped after an earlier move task"))
+			}
+		}
+		break
+	}
+
+	results := make([]BatchResult, 0, len(plan.Results))
+	seen := make(map[string]bool)
+	for _, key := range keys {
+		key = strings.ToUpper(key)
+		if result, ok := plan.Results[key]; ok && !seen[key] {
+			results = append(results, result)
+			seen[key] = true
+		}
+	}
+	return PrintBatchResults(results)
+}
+
+// runRelocateBatch submits one bulk move, waits until deadline for it to
+// finish, and stores the result for each issue in results.
+func runRelocateBatch(ctx context.Context, client *api.Client, batch *relocateBatch, deadline time.Time, results map[string]BatchResult, progress io.Writer) error {
+	req := bulkMoveRequest{
+		SendBulkNotification:   !relocateNoNotify,
+		TargetToSourcesMapping: batch.Targets,
+	}
+	taskID, err := submitBulkMove(ctx, client, req)
+	if err != nil {
+		if _, ok := err.(*api.APIError); ok {
+			return err
+		}
+		return fmt.Errorf("failed to start move: %w", err)
+	}
+
+	waitCtx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+	task, err := waitBulkTask(waitCtx, client, taskID, progress)
+	if err != nil {
+		return err
+	}
+
+	for key, result := range relocateResults(batch.Moving, task) {
+		results[key] = result
+	}
+	return nil
+}
+
+// parseStatusMap parses "<from>=<to>" pairs into a map keyed by the
+// lower-cased source status.
+func parseStatusMap(values []string) (map[string]string, error) {
+	statusMap := make(map[string]string, len(values))
+	for _, v := range values {
+		from, to, ok := strings.Cut(v, "=")
+		from, to = strings.TrimSpace(from), strings.TrimSpace(to)
+		if !ok || from == "" || to == "" {
+			return nil, fmt.Errorf("invalid status mapping: %s (use <from>=<to>)", v)
+		}
+		statusMap[strings.ToLower(from)] = to
+	}
+	return statusMap, nil
+}
+
+// fetchRelocateIssues looks up the issues by key, 50 keys per search. The
+// result is keyed by upper-case issue key.
+func fetchRelocateIssues(ctx context.Context, client *api.Client, keys []string) (map[string]relocateIssue, error) {
+	issues := make(map[string]relocateIssue, len(keys))
+	for start := 0; start < len(keys); start += 50 {
+		chunk := keys[start:min(start+50, len(keys))]
+		path := fmt.Sprintf("/search/jql?jql=%s&maxResults=%d&fields=project,issuetype,status",
+			url.QueryEscape(keysJQL(chunk)), len(chunk))
+
+		body, err := client.Get(ctx, path)
+		if err != nil {
+			return nil, err
+		}
+
+		var resp relocateSearchResponse
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return nil, fmt.Errorf("failed to parse response: %w", err)
+		}
+
+		for _, i := range resp.Issues {
+			issues[i.Key] = relocateIssue{
+				ID:          i.ID,
+				Key:         i.Key,
+				Project:     i.Fields.Project.Key,
+				IssueTypeID: i.Fields.IssueType.ID,
+				IssueType:   i.Fields.IssueType.Name,
+				StatusID:    i.Fields.Status.ID,
+				Status:      i.Fields.Status.Name,
+			}
+		}
+	}
+	return issues, nil
+}
+
+// planRelocation groups the issues by target project and type and maps their
+// statuses onto the target workflow. Results are keyed by upper-case key.
+func planRelocation(ctx context.Context, client *api.Client, keys []string, issues map[string]relocateIssue, toProject, toType string, statusMap map[string]string) (*relocation, error) {
+	plan := &relocation{
+		Results: make(map[string]BatchResult),
+	}
+	var batch *relocateBatch
+	typesByProject := make(map[string][]jira.IssueType)
+	statusesByTarget := make(map[string][]jira.Status)
+
+	for _, key := range keys {
+		key = strings.ToUpper(key)
+		if _, ok := plan.Results[key]; ok {
+			continue
+		}
+		issue, ok := issues[key]
+		if !ok {
+			plan.Results[key] = BatchResult{Key: key, Error: "issue not found"}
+			continue
+		}
+
+		project := strings.ToUpper(toProject)
+		if project == "" {
+			project = issue.Project
+		}
+		typeName := toType
+		if typeName == "" {
+			typeName = issue.IssueType
+		}
+
+		types, ok := typesByProject[project]
+		if !ok {
+			var err error
+			types, err = jira.GetIssueTypes(ctx, client, project)
+			if err != nil {
+				return nil, err
+			}
+			typesByProject[project] = types
+		}
+		var target *jira.IssueType
+		for i, t := range types {
+			if strings.EqualFold(t.Name, typeName) {
+				target = &types[i]
+				break
+			}
+		}
+		if target == nil {
+			plan.Results[key] = BatchResult{Key: key, Error: fmt.Sprintf("issue type %s not found in project %s", typeName, project)}
+			continue
+		}
+		if project == issue.Project && target.ID == issue.IssueTypeID {
+			plan.Results[key] = BatchResult{Key: key, Success: true}
+			continue
+		}
+
+		targetKey := project + "," + target.ID
+		statuses, ok := statusesByTarget[targetKey]
+		if !ok {
+			var err error
+			statuses, err = jira.GetIssueTypeStatuses(ctx, client, project, target.ID)
+			if err != nil {
+				return nil, err
+			}
+			statusesByTarget[targetKey] = statuses
+		}
+
+		statusName := issue.Status
+		mapped, explicit := statusMap[strings.ToLower(issue.Status)]
+		if explicit {
+			statusName = mapped
+		}
+		var status *jira.Status
+		for i, s := range statuses {
+			if strings.EqualFold(s.Name, statusName) {
+				status = &statuses[i]
+				break
+			}
+		}
+		if status == nil && explicit {
+			return nil, fmt.Errorf("status %s not found in the %s workflow of project %s", mapped, target.Name, project)
+		}
+
+		if batch == nil || len(batch.Moving) == bulkMoveBatchSize {
+			batch = &relocateBatch{Targets: make(map[string]*bulkMoveTarget)}
+			plan.Batches = append(plan.Batches, batch)
+		}
+		t := batch.Targets[targetKey]
+		if t == nil {
+			t = &bulkMoveTarget{
+				InferClassificationDefaults: true,
+				InferFieldDefaults:          true,
+				InferStatusDefaults:         true,
+				InferSubtaskTypeDefault:     true,
+			}
+			batch.Targets[targetKey] = t
+		}
+		t.IssueIdsOrKeys = append(t.IssueIdsOrKeys, issue.Key)
+
+		p := RelocatePlan{Key: issue.Key, Project: project, IssueType: target.Name, FromStatus: issue.Status}
+		if status != nil {
+			p.ToStatus = status.Name
+			addBulkMoveStatus(t, status.ID, issue.StatusID)
+		}
+		plan.Plans = append(plan.Plans, p)
+		plan.Moving = append(plan.Moving, issue)
+		batch.Moving = append(batch.Moving, issue)
+		plan.Results[key] = BatchResult{Key: key}
+	}
+
+	return plan, nil
+}
+
+func addBulkMoveStatus(t *bulkMoveTarget, targetID, sourceID string) {
+	if len(t.TargetStatus) == 0 {
+		t.TargetStatus = []bulkMoveStatus{{Statuses: make(map[string][]bulkMoveSourceStatus)}}
+	}
+	statuses := t.TargetStatus[0].Statuses
+	for _, s := range statuses[targetID] {
+		if s.StatusID == sourceID {
+			return
+		}
+	}
+	statuses[targetID] = append(statuses[targetID], bulkMoveSourceStatus{StatusID: sourceID})
+}
+
+func submitBulkMove(ctx context.Context, client *api.Client, req bulkMoveRequest) (string, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	respBody, err := client.Post(ctx, "/bulk/issues/move", body)
+	if err != nil {
+		return "", err
+	}
+
+	var resp struct {
+		TaskID string `json:"taskId"`
+	}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return "", fmt.Errorf("failed to parse response: %w", err)
+	}
+	return resp.TaskID, nil
+}
+
+// waitBulkTask polls a bulk operation until it finishes or ctx is done,
+// writing progress changes to progress when set. The error for a task still
+// running names the task so it can be checked later.
+func waitBulkTask(ctx context.Context, client *api.Client, taskID string, progress io.Writer) (*bulkTaskResponse, error) {
+	last := -1
+	for {
+		body, err := client.Get(ctx, fmt.Sprintf("/bulk/queue/%s", url.PathEscape(taskID)))
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, stoppedWaiting(ctx, taskID)
+			}
+			return nil, err
+		}
+
+		var task bulkTaskResponse
+		if err := json.Unmarshal(body, &task); err != nil {
+			return nil, fmt.Errorf("failed to parse response: %w", err)
+		}
+
+		if progress != nil && task.ProgressPercent != last {
+			fmt.Fprintf(progress, "Moving issues: %d%%\n", task.ProgressPercent)
+			last = task.ProgressPercent
+		}
+
+		switch task.Status {
+		case "COMPLETE", "FAILED", "CANCELLED", "DEAD":
+			return &task, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, stoppedWaiting(ctx, taskID)
+		case <-time.After(relocatePollInterval):
+		}
+	}
+}
+
+func stoppedWaiting(ctx context.Context, taskID string) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("timed out waiting for move task %s (it continues in Jira; check GET /rest/api/3/bulk/queue/%s): %w", taskID, taskID, ctx.Err())
+	}
+	return fmt.Errorf("stopped waiting for move task %s (it continues in Jira): %w", taskID, ctx.Err())
+}
+
+// relocateResults reports each moved issue from the finished task.
+func relocateResults(moving []relocateIssue, task *bulkTaskResponse) map[string]BatchResult {
+	processed := make(map[string]bool, len(task.ProcessedAccessibleIssues))
+	for _, id := range task.ProcessedAccessibleIssues {
+		processed[strconv.FormatInt(id, 10)] = true
+	}
+
+	results := make(map[string]BatchResult, len(moving))
+	for _, issue := range moving {
+		result := BatchResult{Key: issue.Key}
+		if errs, failed := task.FailedAccessibleIssues[issue.ID]; failed {
+			result.Error = strings.Join(errs, "; ")
+		} else if processed[issue.ID] {
+			result.Success = true
+		} else {
+			result.Error = fmt.Sprintf("not moved (task %s)", strings.ToLower(task.Status))
+		}
+		results[issue.Key] = result
+	}
+	return results
+}
+
+func printRelocatePlans(plans []RelocatePlan) {
+	if JSONOutput() {
+		output, err := json.MarshalIndent(plans, "", "  ")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to format JSON: %v\n", err)
+			return
+		}
+		fmt.Println(string(output))
+		return
+	}
+
+	for _, p := range plans {
+		toStatus := p.ToStatus
+		if toStatus == "" {
+			toStatus = "default status"
+		}
+		fmt.Printf("Would relocate %s to %s as %s (%s -> %s)\n", p.Key, p.Project, p.IssueType, p.FromStatus, toStatus)
+	}
+}
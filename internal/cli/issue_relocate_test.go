@@ -0,0 +1,207 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grantcarthew/ajira/internal/api"
+)
+
+func TestParseStatusMap(t *testing.T) {
+	got, err := parseStatusMap([]string{"Doing=In Progress", " To Do = Backlog "})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got["doing"] != "In Progress" || got["to do"] != "Backlog" {
+		t.Errorf("unexpected map: %v", got)
+	}
+
+	for _, bad := range []string{"Doing", "=Done", "Doing="} {
+		if _, err := parseStatusMap([]string{bad}); err == nil {
+			t.Errorf("expected error for %q", bad)
+		}
+	}
+}
+
+func TestPlanRelocation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/api/3/search/jql":
+			if !strings.Contains(r.URL.Query().Get("jql"), `"OLD-2"`) {
+				t.Errorf("unexpected jql: %s", r.URL.Query().Get("jql"))
+			}
+			_, _ = w.Write([]byte(`{"issues": [
+				{"id": "101", "key": "OLD-1", "fields": {"project": {"key": "OLD"}, "issuetype": {"id": "1", "name": "Task"}, "status": {"id": "31", "name": "Doing"}}},
+				{"id": "102", "key": "OLD-2", "fields": {"project": {"key": "OLD"}, "issuetype": {"id": "2", "name": "Bug"}, "status": {"id": "32", "name": "Done"}}},
+				{"id": "103", "key": "OLD-3", "fields": {"project": {"key": "OLD"}, "issuetype": {"id": "1", "name": "Task"}, "status": {"id": "33", "name": "Parked"}}}
+			]}`))
+		case "/rest/api/3/issue/createmeta/NEW/issuetypes":
+			_, _ = w.Write([]byte(`{"issueTypes": [{"id": "10", "name": "Task"}, {"id": "11", "name": "Story"}]}`))
+		case "/rest/api/3/project/NEW/statuses":
+			_, _ = w.Write([]byte(`[
+				{"id": "10", "name": "Task", "statuses": [{"id": "40", "name": "In Progress"}, {"id": "41", "name": "Done"}]},
+				{"id": "11", "name": "Story", "statuses": [{"id": "50", "name": "Open"}]}
+			]`))
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	keys := []string{"old-1", "OLD-2", "OLD-3", "OLD-9"}
+
+	issues, err := fetchRelocateIssues(context.Background(), client, keys)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	plan, err := planRelocation(context.Background(), client, keys, issues, "new", "", map[string]string{"doing": "In Progress"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// OLD-2 is a Bug, which NEW does not have; OLD-9 does not exist.
+	if plan.Results["OLD-2"].Success || !strings.Contains(plan.Results["OLD-2"].Error, "Bug not found in project NEW") {
+		t.Errorf("unexpected OLD-2 result: %+v", plan.Results["OLD-2"])
+	}
+	if plan.Results["OLD-9"].Error != "issue not found" {
+		t.Errorf("unexpected OLD-9 result: %+v", plan.Results["OLD-9"])
+	}
+
+	if len(plan.Moving) != 2 || len(plan.Batches) != 1 || len(plan.Batches[0].Targets) != 1 {
+		t.Fatalf("expected 2 issues in 1 target, got %+v", plan.Batches)
+	}
+	target := plan.Batches[0].Targets["NEW,10"]
+	if target == nil || strings.Join(target.IssueIdsOrKeys, ",") != "OLD-1,OLD-3" || !target.InferStatusDefaults {
+		t.Fatalf("unexpected target: %+v", target)
+	}
+	statuses, _ := json.Marshal(target.TargetStatus)
+	if string(statuses) != `[{"statuses":{"40":[{"statusId":"31"}]}}]` {
+		t.Errorf("unexpected status mapping: %s", statuses)
+	}
+
+	if plan.Plans[0].ToStatus != "In Progress" || plan.Plans[1].ToStatus != "" {
+		t.Errorf("unexpected plans: %+v", plan.Plans)
+	}
+
+	_, err = planRelocation(context.Background(), client, keys, issues, "NEW", "", map[string]string{"doing": "Review"})
+	if err == nil || !strings.Contains(err.Error(), "Review") {
+		t.Errorf("expected unknown target status error, got %v", err)
+	}
+}
+
+func TestPlanRelocation_Batches(t *testing.T) {
+	server := jsonServer(map[string]any{
+		"/rest/api/3/issue/createmeta/NEW/issuetypes": `{"issueTypes": [{"id": "10", "name": "Task"}]}`,
+		"/rest/api/3/project/NEW/statuses":            `[{"id": "10", "name": "Task", "statuses": [{"id": "40", "name": "In Progress"}]}]`,
+	})
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	count := bulkMoveBatchSize + 1
+	keys := make([]string, count)
+	issues := make(map[string]relocateIssue, count)
+	for i := range count {
+		key := fmt.Sprintf("OLD-%d", i+1)
+		keys[i] = key
+		issues[key] = relocateIssue{ID: fmt.Sprint(100 + i), Key: key, Project: "OLD", IssueTypeID: "1", IssueType: "Task", StatusID: "31", Status: "In Progress"}
+	}
+
+	plan, err := planRelocation(context.Background(), client, keys, issues, "NEW", "", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(plan.Batches) != 2 {
+		t.Fatalf("expected 2 batches, got %d", len(plan.Batches))
+	}
+	for i, want := range []int{bulkMoveBatchSize, 1} {
+		batch := plan.Batches[i]
+		if len(batch.Moving) != want || len(batch.Targets["NEW,10"].IssueIdsOrKeys) != want {
+			t.Errorf("batch %d: expected %d issues, got %d", i, want, len(batch.Moving))
+		}
+	}
+	if key := plan.Batches[1].Moving[0].Key; key != keys[count-1] {
+		t.Errorf("expected the last key in the second batch, got %s", key)
+	}
+}
+
+func TestWaitBulkTask_Timeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"taskId": "77", "status": "RUNNING", "progressPercent": 10}`))
+	}))
+	defer server.Close()
+
+	old := relocatePollInterval
+	relocatePollInterval = time.Millisecond
+	defer func() { relocatePollInterval = old }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	client := api.NewClient(testConfig(server.URL))
+	_, err := waitBulkTask(ctx, client, "77", nil)
+	if err == nil || !strings.Contains(err.Error(), "timed out waiting for move task 77") {
+		t.Fatalf("expected timeout naming the task, got %v", err)
+	}
+	if code := ExitCodeFromError(err); code != ExitTimeout {
+		t.Errorf("expected exit code %d, got %d", ExitTimeout, code)
+	}
+}
+
+func TestWaitBulkTask(t *testing.T) {
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/bulk/queue/77" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		polls++
+		w.Header().Set("Content-Type", "application/json")
+		if polls < 3 {
+			_, _ = w.Write([]byte(`{"taskId": "77", "status": "RUNNING", "progressPercent": 50}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"taskId": "77", "status": "COMPLETE", "progressPercent": 100,
+			"processedAccessibleIssues": [101], "failedAccessibleIssues": {"103": ["Field Team is required"]}}`))
+	}))
+	defer server.Close()
+
+	old := relocatePollInterval
+	relocatePollInterval = 0
+	defer func() { relocatePollInterval = old }()
+
+	var progress bytes.Buffer
+	client := api.NewClient(testConfig(server.URL))
+	task, err := waitBulkTask(context.Background(), client, "77", &progress)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if polls != 3 || task.Status != "COMPLETE" {
+		t.Errorf("expected 3 polls until complete, got %d (%s)", polls, task.Status)
+	}
+	if progress.String() != "Moving issues: 50%\nMoving issues: 100%\n" {
+		t.Errorf("unexpected progress output: %q", progress.String())
+	}
+
+	moving := []relocateIssue{{ID: "101", Key: "OLD-1"}, {ID: "103", Key: "OLD-3"}, {ID: "104", Key: "OLD-4"}}
+	results := relocateResults(moving, task)
+	if !results["OLD-1"].Success {
+		t.Errorf("expected OLD-1 to succeed: %+v", results["OLD-1"])
+	}
+	if results["OLD-3"].Success || results["OLD-3"].Error != "Field Team is required" {
+		t.Errorf("unexpected OLD-3 result: %+v", results["OLD-3"])
+	}
+	if results["OLD-4"].Error != "not moved (task complete)" {
+		t.Errorf("unexpected OLD-4 result: %+v", results["OLD-4"])
+	}
+}
@@ -28,6 +28,24 @@ func testConfig(serverURL string) *config.Config {
 	}
 }
 
+// jsonServer serves the response for each request path in routes: strings as
+// raw JSON, other values encoded. Paths not in routes return 404.
+func jsonServer(routes map[string]any) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp, ok := routes[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if raw, ok := resp.(string); ok {
+			_, _ = w.Write([]byte(raw))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+}
+
 // Test buildJQL function
 func TestBuildJQL_EmptyFilters(t *testing.T) {
 	// Reset global state
@@ -657,6 +675,272 @@ func TestGetIssue_NullDescription(t *testing.T) {
 	}
 }
 
+// issueDetailFixture is an issue with parent, subtasks, sprint, and custom
+// fields, returned with expand=names.
+const issueDetailFixture = `{
+	"key": "TEST-7",
+	"names": {
+		"summary": "Summary",
+		"customfield_10020": "Sprint",
+		"customfield_10050": "Severity",
+		"customfield_10060": "Acceptance Criteria",
+		"customfield_10070": "Team"
+	},
+	"fields": {
+		"summary": "Full detail",
+		"parent": {"key": "TEST-1", "fields": {"summary": "Epic", "status": {"name": "In Progress"}, "issuetype": {"name": "Epic"}}},
+		"subtasks": [
+			{"key": "TEST-8", "fields": {"summary": "Sub one", "status": {"name": "Done"}}},
+			{"key": "TEST-9", "fields": {"summary": "Sub two", "status": {"name": "To Do"}}}
+		],
+		"components": [{"name": "API"}, {"name": "Web"}],
+		"fixVersions": [{"name": "1.2.0"}],
+		"resolution": {"name": "Fixed"},
+		"customfield_10020": [
+			{"id": 1, "name": "Sprint 1", "state": "closed"},
+			{"id": 2, "name": "Sprint 2", "state": "active"}
+		],
+		"customfield_10050": {"value": "High"},
+		"customfield_10060": {"type": "doc", "version": 1, "content": [{"type": "paragraph", "content": [{"type": "text", "text": "Must pass"}]}]},
+		"customfield_10070": null
+	}
+}`
+
+func TestGetIssue_FullDetail(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("expand") != "names" {
+			t.Errorf("expected expand=names, got %q", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(issueDetailFixture))
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	issue, err := getIssue(context.Background(), client, "TEST-7")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if issue.Parent == nil || issue.Parent.Key != "TEST-1" || issue.Parent.Type != "Epic" {
+		t.Errorf("unexpected parent: %+v", issue.Parent)
+	}
+	if len(issue.Subtasks) != 2 || issue.Subtasks[0].Key != "TEST-8" || issue.Subtasks[0].Status != "Done" {
+		t.Errorf("unexpected subtasks: %+v", issue.Subtasks)
+	}
+	if strings.Join(issue.Components, ",") != "API,Web" {
+		t.Errorf("unexpected components: %v", issue.Components)
+	}
+	if strings.Join(issue.FixVersions, ",") != "1.2.0" {
+		t.Errorf("unexpected fix versions: %v", issue.FixVersions)
+	}
+	if issue.Resolution != "Fixed" {
+		t.Errorf("expected resolution Fixed, got %q", issue.Resolution)
+	}
+	if issue.Sprint != "Sprint 2" {
+		t.Errorf("expected active sprint 'Sprint 2', got %q", issue.Sprint)
+	}
+	if issue.CustomFields != nil {
+		t.Errorf("expected no custom fields without selection, got %+v", issue.CustomFields)
+	}
+}
+
+func TestGetIssueWithFields_All(t *testing.T) {
+	server := jsonServer(map[string]any{"/rest/api/3/issue/TEST-7": issueDetailFixture})
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	issue, err := getIssueWithFields(context.Background(), client, "TEST-7", []string{"all"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Sorted by name; the empty Team field is skipped.
+	want := []CustomField{
+		{ID: "customfield_10060", Name: "Acceptance Criteria", Value: "Must pass"},
+		{ID: "customfield_10050", Name: "Severity", Value: "High"},
+		{ID: "customfield_10020", Name: "Sprint", Value: "Sprint 1, Sprint 2"},
+	}
+	if len(issue.CustomFields) != len(want) {
+		t.Fatalf("expected %d custom fields, got %+v", len(want), issue.CustomFields)
+	}
+	for i, f := range want {
+		if issue.CustomFields[i] != f {
+			t.Errorf("field %d: expected %+v, got %+v", i, f, issue.CustomFields[i])
+		}
+	}
+}
+
+func TestGetIssueWithFields_Named(t *testing.T) {
+	server := jsonServer(map[string]any{"/rest/api/3/issue/TEST-7": issueDetailFixture})
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	issue, err := getIssueWithFields(context.Background(), client, "TEST-7", parseViewFields("team, customfield_10050"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(issue.CustomFields) != 2 {
+		t.Fatalf("expected 2 custom fields, got %+v", issue.CustomFields)
+	}
+	if issue.CustomFields[0].Name != "Team" || issue.CustomFields[0].Value != "" {
+		t.Errorf("expected empty Team field first, got %+v", issue.CustomFields[0])
+	}
+	if issue.CustomFields[1].Name != "Severity" || issue.CustomFields[1].Value != "High" {
+		t.Errorf("expected Severity High second, got %+v", issue.CustomFields[1])
+	}
+
+	_, err = getIssueWithFields(context.Background(), client, "TEST-7", []string{"Nope"})
+	if err == nil || !strings.Contains(err.Error(), "field not found") {
+		t.Errorf("expected field not found error, got %v", err)
+	}
+}
+
+func TestSearchIssueDetails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/search/jql" {
+			t.Errorf("expected search path, got %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("jql") != `key in ("TEST-2", "TEST-1")` {
+			t.Errorf("unexpected jql: %s", q.Get("jql"))
+		}
+		if q.Get("fields") != "*all" || q.Get("expand") != "names" {
+			t.Errorf("expected fields=*all and expand=names, got %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"isLast": true,
+			"names": {"customfield_10050": "Severity"},
+			"issues": [
+				{"key": "TEST-1", "fields": {"summary": "First", "customfield_10050": {"value": "Low"}}},
+				{"key": "TEST-2", "fields": {"summary": "Second", "comment": {"total": 3, "comments": [
+					{"id": "1", "created": "2026-01-01T10:00:00.000+0000", "body": "old"},
+					{"id": "2", "created": "2026-01-02T10:00:00.000+0000", "body": "middle"},
+					{"id": "3", "created": "2026-01-03T10:00:00.000+0000", "body": "new"}
+				]}}}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	keys := []string{"TEST-2", "TEST-1"}
+	issues, err := searchIssueDetails(context.Background(), client, keysJQL(keys), []string{"Severity"}, 2, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	issues, _ = orderIssuesByKeys(issues, keys)
+
+	if len(issues) != 2 || issues[0].Key != "TEST-2" || issues[1].Key != "TEST-1" {
+		t.Fatalf("expected issues in requested order, got %+v", issues)
+	}
+	if len(issues[0].Comments) != 2 || issues[0].Comments[0].ID != "3" || issues[0].Comments[1].ID != "2" {
+		t.Errorf("expected two newest comments, got %+v", issues[0].Comments)
+	}
+	if issues[0].TotalComments != 3 {
+		t.Errorf("expected total comments 3, got %d", issues[0].TotalComments)
+	}
+	if len(issues[1].CustomFields) != 1 || issues[1].CustomFields[0].Value != "Low" {
+		t.Errorf("expected Severity Low, got %+v", issues[1].CustomFields)
+	}
+}
+
+func TestOrderIssuesByKeys(t *testing.T) {
+	issues := []*IssueDetail{{Key: "A-1"}, {Key: "A-2"}, {Key: "B-9"}}
+
+	ordered, unmatched := orderIssuesByKeys(issues, []string{"a-2", "A-1", "A-2", "a-7"})
+
+	var got []string
+	for _, issue := range ordered {
+		got = append(got, issue.Key)
+	}
+	// Duplicates dropped; B-9 (e.g. moved from A-7) appended.
+	if strings.Join(got, ",") != "A-2,A-1,B-9" {
+		t.Errorf("unexpected order: %v", got)
+	}
+	if strings.Join(unmatched, ",") != "A-7" {
+		t.Errorf("expected A-7 unmatched, got %v", unmatched)
+	}
+}
+
+func TestFetchIssueDetailsByKeys(t *testing.T) {
+	var searches []int
+	var lookups []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/rest/api/3/search/jql" {
+			// Direct lookups follow moved keys; A-98 was moved to B-1
+			lookups = append(lookups, r.URL.Path)
+			if r.URL.Path == "/rest/api/3/issue/A-98" {
+				_, _ = w.Write([]byte(`{"key": "B-1", "fields": {"summary": "Moved"}}`))
+				return
+			}
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errorMessages": ["Issue does not exist or you do not have permission to see it."]}`))
+			return
+		}
+		jql := r.URL.Query().Get("jql")
+		searches = append(searches, strings.Count(jql, `"`)/2)
+
+		var issues []string
+		for _, key := range []string{"A-1", "A-2", "A-51"} {
+			if strings.Contains(jql, `"`+key+`"`) {
+				issues = append(issues, `{"key": "`+key+`", "fields": {"summary": "Found"}}`)
+			}
+		}
+		if strings.Contains(jql, `"A-98"`) {
+			issues = append(issues, `{"key": "B-1", "fields": {"summary": "Moved"}}`)
+		}
+		_, _ = w.Write([]byte(`{"isLast": true, "issues": [` + strings.Join(issues, ",") + `]}`))
+	}))
+	defer server.Close()
+	client := api.NewClient(testConfig(server.URL))
+
+	var keys []string
+	for i := 1; i <= 51; i++ {
+		keys = append(keys, fmt.Sprintf("A-%d", i))
+	}
+	issues, missing, err := fetchIssueDetailsByKeys(context.Background(), client, keys, nil, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(searches) != 2 || searches[0] != 50 || searches[1] != 1 {
+		t.Errorf("expected searches of 50 and 1 keys, got %v", searches)
+	}
+	if len(issues) != 3 || len(missing) != 48 || missing[0] != "A-3" {
+		t.Errorf("expected 3 issues and 48 missing keys, got %d and %v", len(issues), missing)
+	}
+	if len(lookups) != 0 {
+		t.Errorf("expected no lookups without moved issues, got %v", lookups)
+	}
+
+	searches = nil
+	issues, missing, err = fetchIssueDetailsByKeys(context.Background(), client, []string{"A-1", "A-98", "A-99"}, nil, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(issues) != 2 || issues[1].Key != "B-1" {
+		t.Errorf("expected A-1 and the moved B-1, got %+v", issues)
+	}
+	if strings.Join(missing, ",") != "A-99" {
+		t.Errorf("expected only A-99 missing, got %v", missing)
+	}
+	if strings.Join(lookups, ",") != "/rest/api/3/issue/A-98,/rest/api/3/issue/A-99" {
+		t.Errorf("unexpected lookups: %v", lookups)
+	}
+
+	err = runIssueViewMany(context.Background(), client, []string{"A-1", "A-99"})
+	if err == nil || !strings.Contains(err.Error(), "issue not found: A-99") || ExitCodeFromError(err) != ExitPartial {
+		t.Errorf("expected partial failure for A-99, got %v", err)
+	}
+	err = runIssueViewMany(context.Background(), client, []string{"A-97", "A-99"})
+	if ExitCodeFromError(err) != ExitNotFound {
+		t.Errorf("expected not found exit code, got %v", err)
+	}
+}
+
 // Test createIssue function
 func TestCreateIssue_Success(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -1530,7 +1814,7 @@ func TestAddComment_Success(t *testing.T) {
 	defer server.Close()
 
 	client := api.NewClient(testConfig(server.URL))
-	result, err := addComment(context.Background(), client, "TEST-123", "This is a **bold** comment")
+	result, err := addComment(context.Background(), client, "TEST-123", "This is a **bold** comment", nil)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -1792,6 +2076,53 @@ func TestSearchIssues_PaginationSafetyGuard(t *testing.T) {
 	}
 }
 
+func TestSearchAllIssueValues_NoPageCap(t *testing.T) {
+	pages := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pages++
+		resp := issueSearchResponse{
+			NextPageToken: fmt.Sprintf("page-%d", pages),
+			IsLast:        pages == 150,
+			Issues:        []issueValue{{Key: fmt.Sprintf("TEST-%d", pages)}},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	issues, err := searchAllIssueValues(context.Background(), client, "project = TEST", "summary")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pages != 150 || len(issues) != 150 {
+		t.Errorf("expected 150 pages and issues, got %d and %d", pages, len(issues))
+	}
+}
+
+func TestSearchAllIssueValues_RepeatedToken(t *testing.T) {
+	pages := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pages++
+		resp := issueSearchResponse{
+			NextPageToken: "always-more",
+			Issues:        []issueValue{{Key: fmt.Sprintf("TEST-%d", pages)}},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	issues, err := searchAllIssueValues(context.Background(), client, "project = TEST", "summary")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pages != 2 || len(issues) != 2 {
+		t.Errorf("expected to stop on the repeated token after 2 pages, got %d pages and %d issues", pages, len(issues))
+	}
+}
+
 func TestEditComment_Success(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPut {
@@ -1821,7 +2152,7 @@ func TestEditComment_Success(t *testing.T) {
 	defer server.Close()
 
 	client := api.NewClient(testConfig(server.URL))
-	result, err := editComment(context.Background(), client, "TEST-123", "12345", "Updated **comment** text")
+	result, err := editComment(context.Background(), client, "TEST-123", "12345", "Updated **comment** text", nil, nil)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -1842,7 +2173,7 @@ func TestEditComment_NotFound(t *testing.T) {
 	defer server.Close()
 
 	client := api.NewClient(testConfig(server.URL))
-	_, err := editComment(context.Background(), client, "TEST-123", "99999", "Updated text")
+	_, err := editComment(context.Background(), client, "TEST-123", "99999", "Updated text", nil, nil)
 	if err == nil {
 		t.Fatal("expected error, got nil")
 	}
@@ -0,0 +1,236 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/fatih/color"
+	"github.com/grantcarthew/ajira/internal/api"
+	"github.com/grantcarthew/ajira/internal/config"
+	"github.com/grantcarthew/ajira/internal/width"
+	"github.com/spf13/cobra"
+)
+
+// treeConcurrency bounds the number of in-flight child searches while
+// walking a hierarchy.
+const treeConcurrency = 5
+
+// TreeNode represents an issue and its descendants for output.
+type TreeNode struct {
+	Key            string        `json:"key"`
+	Summary        string        `json:"summary"`
+	Status         string        `json:"status"`
+	StatusCategory string        `json:"statusCategory"`
+	Type           string        `json:"type"`
+	Assignee       string        `json:"assignee"`
+	Progress       *TreeProgress `json:"progress,omitempty"`
+	Children       []*TreeNode   `json:"children,omitempty"`
+}
+
+// TreeProgress rolls up the status categories of all descendants of a node.
+type TreeProgress struct {
+	Total      int `json:"total"`
+	Done       int `json:"done"`
+	InProgress int `json:"inProgress"`
+	ToDo       int `json:"toDo"`
+	Percent    int `json:"percent"`
+}
+
+var issueTreeDepth int
+
+var issueTreeCmd = &cobra.Command{
+	Use:   "tree <issue-key>",
+	Short: "Show issue hierarchy",
+	Long:  "Display an issue and its descendants (epic, children, subtasks) as an indented tree with progress roll-ups.",
+	Example: `  ajira issue tree GCP-50              # Epic with children and subtasks
+  ajira issue tree GCP-50 --depth 1    # Direct children only
+  ajira issue tree GCP-50 --json       # Nested JSON`,
+	Args:              cobra.ExactArgs(1),
+	SilenceUsage:      true,
+	ValidArgsFunction: completeFirstArg(completeIssueKeys),
+	RunE:              runIssueTree,
+}
+
+func init() {
+	issueTreeCmd.Flags().IntVar(&issueTreeDepth, "depth", 3, "Maximum levels below the root to fetch")
+
+	issueCmd.AddCommand(issueTreeCmd)
+}
+
+func runIssueTree(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	issueKey := args[0]
+
+	if issueTreeDepth < 1 {
+		return fmt.Errorf("--depth must be at least 1")
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(cfg)
+
+	root, err := buildIssueTree(ctx, client, issueKey, issueTreeDepth)
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); ok {
+			return fmt.Errorf("API error: %w", apiErr)
+		}
+		return fmt.Errorf("failed to build issue tree: %w", err)
+	}
+
+	if JSONOutput() {
+		output, err := json.MarshalIndent(root, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to format JSON: %w", err)
+		}
+		fmt.Println(string(output))
+	} else {
+		printIssueTree(root)
+	}
+
+	return nil
+}
+
+// buildIssueTree fetches an issue and recursively fetches its children up to
+// depth levels, then computes progress roll-ups for every non-leaf node.
+func buildIssueTree(ctx context.Context, client *api.Client, key string, depth int) (*TreeNode, error) {
+	issues, err := searchIssues(ctx, client, fmt.Sprintf("key = %s", key), 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(issues) == 0 {
+		return nil, fmt.Errorf("issue not found: %s", key)
+	}
+
+	root := treeNodeFromInfo(issues[0])
+
+	sem := make(chan struct{}, treeConcurrency)
+	if err := expandTreeNode(ctx, client, sem, root, depth); err != nil {
+		return nil, err
+	}
+
+	rollupTreeProgress(root)
+
+	return root, nil
+}
+
+// expandTreeNode fetches the children of node and recurses into each child
+// concurrently. The semaphore is held only for the duration of each search so
+// deep trees cannot deadlock.
+func expandTreeNode(ctx context.Context, client *api.Client, sem chan struct{}, node *TreeNode, depth int) error {
+	if depth <= 0 {
+		return nil
+	}
+
+	select {
+	case sem <- struct{}{}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	children, err := searchIssues(ctx, client, parentJQL(node.Key)+" ORDER BY key ASC", 0)
+	<-sem
+	if err != nil {
+		return err
+	}
+
+	node.Children = make([]*TreeNode, len(children))
+	for i, c := range children {
+		node.Children[i] = treeNodeFromInfo(c)
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, len(node.Children))
+	for i, child := range node.Children {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = expandTreeNode(ctx, client, sem, child, depth-1)
+		}()
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+func treeNodeFromInfo(info IssueInfo) *TreeNode {
+	return &TreeNode{
+		Key:            info.Key,
+		Summary:        info.Summary,
+		Status:         info.Status,
+		StatusCategory: info.StatusCategory,
+		Type:           info.Type,
+		Assignee:       info.Assignee,
+	}
+}
+
+// rollupTreeProgress sets Progress on every node with children, counting all
+// descendants by status category. Returns the node's own roll-up contribution.
+func rollupTreeProgress(node *TreeNode) TreeProgress {
+	var p TreeProgress
+	for _, child := range node.Children {
+		p.Total++
+		switch child.StatusCategory {
+		case "done":
+			p.Done++
+		case "indeterminate":
+			p.InProgress++
+		default:
+			p.ToDo++
+		}
+
+		sub := rollupTreeProgress(child)
+		p.Total += sub.Total
+		p.Done += sub.Done
+		p.InProgress += sub.InProgress
+		p.ToDo += sub.ToDo
+	}
+
+	if p.Total > 0 {
+		p.Percent = p.Done * 100 / p.Total
+		node.Progress = &p
+	}
+
+	return p
+}
+
+func printIssueTree(root *TreeNode) {
+	printTreeNode(root, "", "")
+}
+
+func printTreeNode(node *TreeNode, prefix, childPrefix string) {
+	bold := color.New(color.Bold).SprintFunc()
+	faint := color.New(color.Faint).SprintFunc()
+
+	assignee := node.Assignee
+	if assignee == "" {
+		assignee = "Unassigned"
+	}
+
+	line := fmt.Sprintf("%s%s [%s] %s  (%s, %s)",
+		prefix,
+		bold(node.Key),
+		node.Type,
+		width.Truncate(node.Summary, 60, "..."),
+		colorStatus(node.Status, node.StatusCategory),
+		assignee)
+
+	if node.Progress != nil {
+		line += faint(fmt.Sprintf("  %d/%d done (%d%%)", node.Progress.Done, node.Progress.Total, node.Progress.Percent))
+	}
+
+	fmt.Println(line)
+
+	for i, child := range node.Children {
+		last := i == len(node.Children)-1
+		branch, indent := "├── ", "│   "
+		if last {
+			branch, indent = "└── ", "    "
+		}
+		printTreeNode(child, childPrefix+branch, childPrefix+indent)
+	}
+}
@@ -0,0 +1,120 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/grantcarthew/ajira/internal/api"
+)
+
+func TestBuildIssueTree_Hierarchy(t *testing.T) {
+	results := map[string]string{
+		"key = GCP-1": `[{"key": "GCP-1", "fields": {"status": {"statusCategory": {"key": "indeterminate"}}}}]`,
+		`parent = "GCP-1"`: `[{"key": "GCP-2", "fields": {"status": {"statusCategory": {"key": "done"}}}},
+			{"key": "GCP-3", "fields": {"status": {"statusCategory": {"key": "indeterminate"}}}}]`,
+		`parent = "GCP-3"`: `[{"key": "GCP-4", "fields": {"status": {"statusCategory": {"key": "done"}}}},
+			{"key": "GCP-5", "fields": {"status": {"statusCategory": {"key": "new"}}}}]`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		issues := "[]"
+		for prefix, result := range results {
+			if strings.HasPrefix(r.URL.Query().Get("jql"), prefix) {
+				issues = result
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"isLast": true, "issues": ` + issues + `}`))
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	root, err := buildIssueTree(context.Background(), client, "GCP-1", 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if root.Key != "GCP-1" {
+		t.Errorf("expected root GCP-1, got %s", root.Key)
+	}
+	if len(root.Children) != 2 {
+		t.Fatalf("expected 2 children, got %d", len(root.Children))
+	}
+	if len(root.Children[1].Children) != 2 {
+		t.Fatalf("expected 2 subtasks under GCP-3, got %d", len(root.Children[1].Children))
+	}
+
+	if root.Progress == nil {
+		t.Fatal("expected root progress")
+	}
+	if root.Progress.Total != 4 || root.Progress.Done != 2 || root.Progress.InProgress != 1 || root.Progress.ToDo != 1 {
+		t.Errorf("unexpected root progress: %+v", *root.Progress)
+	}
+	if root.Progress.Percent != 50 {
+		t.Errorf("expected 50%%, got %d", root.Progress.Percent)
+	}
+
+	if root.Children[0].Progress != nil {
+		t.Errorf("expected leaf to have no progress, got %+v", *root.Children[0].Progress)
+	}
+	if p := root.Children[1].Progress; p == nil || p.Total != 2 || p.Done != 1 {
+		t.Errorf("unexpected GCP-3 progress: %+v", p)
+	}
+}
+
+func TestBuildIssueTree_DepthLimit(t *testing.T) {
+	var childQueries int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		jql := r.URL.Query().Get("jql")
+		var issues []issueValue
+		switch {
+		case strings.HasPrefix(jql, "key = "):
+			issues = []issueValue{{Key: "GCP-1"}}
+		case strings.HasPrefix(jql, `parent = "GCP-1"`):
+			childQueries++
+			issues = []issueValue{{Key: "GCP-2"}}
+		default:
+			childQueries++
+			issues = []issueValue{{Key: "GCP-X"}}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(issueSearchResponse{IsLast: true, Issues: issues})
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	root, err := buildIssueTree(context.Background(), client, "GCP-1", 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if childQueries != 1 {
+		t.Errorf("expected 1 child query at depth 1, got %d", childQueries)
+	}
+	if len(root.Children) != 1 || root.Children[0].Children != nil {
+		t.Errorf("expected a single level of children, got %+v", root.Children)
+	}
+}
+
+func TestBuildIssueTree_NotFound(t *testing.T) {
+	server := jsonServer(map[string]any{"/rest/api/3/search/jql": issueSearchResponse{IsLast: true}})
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	_, err := buildIssueTree(context.Background(), client, "GCP-404", 2)
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if !strings.Contains(err.Error(), "issue not found") {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
+
+func TestParentJQL(t *testing.T) {
+	if got := parentJQL("GCP-50"); got != `parent = "GCP-50"` {
+		t.Errorf("unexpected JQL: %s", got)
+	}
+}
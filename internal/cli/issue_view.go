@@ -3,8 +3,13 @@ package cli
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
 	"os"
+	"slices"
+	"sort"
 	"strings"
 
 	"github.com/grantcarthew/ajira/internal/api"
@@ -28,12 +33,29 @@ type IssueDetail struct {
 	Description   string           `json:"description"`
 	Labels        []string         `json:"labels"`
 	Project       string           `json:"project"`
+	Parent        *IssueInfo       `json:"parent,omitempty"`
+	Subtasks      []IssueInfo      `json:"subtasks,omitempty"`
+	Components    []string         `json:"components,omitempty"`
+	FixVersions   []string         `json:"fixVersions,omitempty"`
+	Sprint        string           `json:"sprint,omitempty"`
+	Resolution    string           `json:"resolution,omitempty"`
+	DueDate       string           `json:"dueDate,omitempty"`
+	TimeTracking  *TimeTracking    `json:"timeTracking,omitempty"`
+	CustomFields  []CustomField    `json:"customFields,omitempty"`
 	Links         []LinkInfo       `json:"links,omitempty"`
 	Attachments   []AttachmentInfo `json:"attachments,omitempty"`
 	Comments      []CommentInfo    `json:"comments,omitempty"`
 	TotalComments int              `json:"total_comments,omitempty"`
 }
 
+// CustomField is a custom field value rendered as text. Rich text fields
+// are converted to Markdown.
+type CustomField struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
 // LinkInfo represents a linked issue for display.
 type LinkInfo struct {
 	Direction string `json:"direction"`
@@ -71,10 +93,12 @@ func linksToLinkInfos(links []issueLink) []LinkInfo {
 
 // CommentInfo represents a comment on an issue.
 type CommentInfo struct {
-	ID      string `json:"id"`
-	Author  string `json:"author"`
-	Created string `json:"created"`
-	Body    string `json:"body"`
+	ID         string `json:"id"`
+	Author     string `json:"author"`
+	Created    string `json:"created"`
+	Body       string `json:"body"`
+	Visibility string `json:"visibility,omitempty"`
+	Internal   bool   `json:"internal,omitempty"`
 }
 
 // commentsResponse matches the Jira comments API response.
@@ -86,32 +110,76 @@ type commentsResponse struct {
 }
 
 type commentValue struct {
-	ID      string          `json:"id"`
-	Author  *userField      `json:"author"`
-	Created string          `json:"created"`
-	Body    json.RawMessage `json:"body"`
+	ID         string             `json:"id"`
+	Author     *userField         `json:"author"`
+	Created    string             `json:"created"`
+	Body       json.RawMessage    `json:"body"`
+	Visibility *commentVisibility `json:"visibility"`
+	Properties []commentProperty  `json:"properties"`
 }
 
 // issueDetailResponse matches the Jira issue API response.
+// Names maps field IDs to display names (expand=names).
 type issueDetailResponse struct {
 	Key    string            `json:"key"`
 	Fields issueDetailFields `json:"fields"`
+	Names  map[string]string `json:"names"`
 }
 
 type issueDetailFields struct {
-	Summary     string             `json:"summary"`
-	Status      *statusField       `json:"status"`
-	IssueType   *issueType         `json:"issuetype"`
-	Priority    *priorityField     `json:"priority"`
-	Assignee    *userField         `json:"assignee"`
-	Reporter    *userField         `json:"reporter"`
-	Created     string             `json:"created"`
-	Updated     string             `json:"updated"`
-	Description json.RawMessage    `json:"description"`
-	Labels      []string           `json:"labels"`
-	Project     *projectField      `json:"project"`
-	IssueLinks  []issueLink        `json:"issuelinks"`
-	Attachment  []attachmentDetail `json:"attachment"`
+	Summary      string             `json:"summary"`
+	Status       *statusField       `json:"status"`
+	IssueType    *issueType         `json:"issuetype"`
+	Priority     *priorityField     `json:"priority"`
+	Assignee     *userField         `json:"assignee"`
+	Reporter     *userField         `json:"reporter"`
+	Created      string             `json:"created"`
+	Updated      string             `json:"updated"`
+	Description  json.RawMessage    `json:"description"`
+	Labels       []string           `json:"labels"`
+	Project      *projectField      `json:"project"`
+	IssueLinks   []issueLink        `json:"issuelinks"`
+	Attachment   []attachmentDetail `json:"attachment"`
+	DueDate      string             `json:"duedate"`
+	TimeTracking *TimeTracking      `json:"timetracking"`
+	Parent       *issueValue        `json:"parent"`
+	Subtasks     []issueValue       `json:"subtasks"`
+	Components   []componentName    `json:"components"`
+	FixVersions  []versionName      `json:"fixVersions"`
+	Resolution   *struct {
+		Name string `json:"name"`
+	} `json:"resolution"`
+	Comment *struct {
+		Comments []commentValue `json:"comments"`
+		Total    int            `json:"total"`
+	} `json:"comment"`
+
+	// Raw holds every returned field by ID, for the Sprint field and other
+	// custom fields.
+	Raw map[string]json.RawMessage `json:"-"`
+}
+
+func (f *issueDetailFields) UnmarshalJSON(data []byte) error {
+	type plain issueDetailFields
+	if err := json.Unmarshal(data, (*plain)(f)); err != nil {
+		return err
+	}
+	return json.Unmarshal(data, &f.Raw)
+}
+
+// issueDetailSearchResponse matches the Jira search API response with all
+// fields and expand=names.
+type issueDetailSearchResponse struct {
+	Issues        []issueDetailResponse `json:"issues"`
+	Names         map[string]string     `json:"names"`
+	NextPageToken string                `json:"nextPageToken"`
+	IsLast        bool                  `json:"isLast"`
+}
+
+// sprintDetail matches an entry of the Sprint custom field.
+type sprintDetail struct {
+	Name  string `json:"name"`
+	State string `json:"state"`
 }
 
 type attachmentDetail struct {
@@ -131,30 +199,59 @@ type projectField struct {
 
 var (
 	viewCommentCount int
+	viewFields       string
+	viewStdin        bool
+	viewQuery        string
+	viewLimit        int
 )
 
 var issueViewCmd = &cobra.Command{
-	Use:   "view <issue-key>",
+	Use:   "view <issue-keys...>",
 	Short: "View issue",
-	Long:  "Display issue details with 5 most recent comments. Use -c to change comment count.",
-	Example: `  ajira issue view PROJ-123           # View with 5 recent comments
-  ajira issue view PROJ-123 -c 10     # Include 10 recent comments
-  ajira issue view PROJ-123 -c 0      # Hide comments
-  ajira issue view PROJ-123 --json    # JSON output`,
-	Args:         cobra.ExactArgs(1),
-	SilenceUsage: true,
-	RunE:         runIssueView,
+	Long: `Display issue details with 5 most recent comments. Use -c to change comment count.
+Includes parent, subtasks, components, fix versions, sprint, resolution, due date, and time tracking.
+Use --fields to add custom fields by name or ID, or --fields all for every custom field with a value.
+Several keys, --stdin, or -q fetch the issues by search (50 keys per request) and output a JSON array or one
+report per issue. Keys that match no issue are reported as an error after the issues found.`,
+	Example: `  ajira issue view PROJ-123                        # View with 5 recent comments
+  ajira issue view PROJ-123 -c 10                  # Include 10 recent comments
+  ajira issue view PROJ-123 -c 0                   # Hide comments
+  ajira issue view PROJ-123 --fields all           # Include all custom fields
+  ajira issue view PROJ-123 --fields "Team,Severity"
+  ajira issue view PROJ-123 --json                 # JSON output
+  ajira issue view PROJ-1 PROJ-2 PROJ-3 --json     # JSON array
+  ajira issue list -q "sprint in openSprints()" --json | jq -r '.[].key' | ajira issue view --stdin
+  ajira issue view -q "labels = review" -c 0       # Every issue matching a query`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if viewStdin && viewQuery != "" {
+			return fmt.Errorf("--stdin and --query cannot be used together")
+		}
+		if viewStdin || viewQuery != "" {
+			if len(args) != 0 {
+				return fmt.Errorf("with --stdin or --query, no arguments should be provided")
+			}
+		} else if len(args) < 1 {
+			return fmt.Errorf("requires at least 1 argument: <issue-keys...>")
+		}
+		return nil
+	},
+	SilenceUsage:      true,
+	ValidArgsFunction: completeIssueKeys,
+	RunE:              runIssueView,
 }
 
 func init() {
 	issueViewCmd.Flags().IntVarP(&viewCommentCount, "comments", "c", 5, "Number of recent comments to show (0 to hide)")
+	issueViewCmd.Flags().StringVar(&viewFields, "fields", "", "Custom fields to include: all, or comma-separated names or IDs")
+	issueViewCmd.Flags().BoolVar(&viewStdin, "stdin", false, "Read issue keys from stdin (one per line)")
+	issueViewCmd.Flags().StringVarP(&viewQuery, "query", "q", "", "View every issue matching a JQL query")
+	issueViewCmd.Flags().IntVarP(&viewLimit, "limit", "l", 50, "Maximum issues to view with --query")
 
 	issueCmd.AddCommand(issueViewCmd)
 }
 
 func runIssueView(cmd *cobra.Command, args []string) error {
 	ctx := cmd.Context()
-	issueKey := args[0]
 
 	cfg, err := config.Load()
 	if err != nil {
@@ -163,7 +260,12 @@ func runIssueView(cmd *cobra.Command, args []string) error {
 
 	client := api.NewClient(cfg)
 
-	issue, err := getIssue(ctx, client, issueKey)
+	if viewStdin || viewQuery != "" || len(args) > 1 {
+		return runIssueViewMany(ctx, client, args)
+	}
+	issueKey := args[0]
+
+	issue, err := getIssueWithFields(ctx, client, issueKey, parseViewFields(viewFields))
 	if err != nil {
 		if apiErr, ok := err.(*api.APIError); ok {
 			return fmt.Errorf("API error: %w", apiErr)
@@ -199,8 +301,237 @@ func runIssueView(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// runIssueViewMany views several issues fetched by search. Requested keys
+// that match no issue are reported as an error after the issues found.
+func runIssueViewMany(ctx context.Context, client *api.Client, args []string) error {
+	jql := viewQuery
+	limit := viewLimit
+	var keys []string
+
+	if viewQuery == "" {
+		keys = args
+		if viewStdin {
+			var err error
+			keys, err = ReadKeysFromStdin()
+			if err != nil {
+				return err
+			}
+			if len(keys) == 0 {
+				return fmt.Errorf("no issue keys provided via stdin")
+			}
+		}
+	}
+
+	var issues []*IssueDetail
+	var missing []string
+	var err error
+	if keys != nil {
+		issues, missing, err = fetchIssueDetailsByKeys(ctx, client, keys, parseViewFields(viewFields), viewCommentCount)
+	} else {
+		issues, err = searchIssueDetails(ctx, client, jql, parseViewFields(viewFields), viewCommentCount, limit)
+	}
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); ok {
+			return fmt.Errorf("API error: %w", apiErr)
+		}
+		return fmt.Errorf("failed to fetch issues: %w", err)
+	}
+
+	if JSONOutput() {
+		output, err := json.MarshalIndent(issues, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to format JSON: %w", err)
+		}
+		fmt.Println(string(output))
+	} else if len(issues) == 0 {
+		if len(missing) == 0 {
+			fmt.Println("No issues found.")
+		}
+	} else {
+		for i, issue := range issues {
+			if i > 0 {
+				fmt.Println()
+				fmt.Println(strings.Repeat("=", 60))
+				fmt.Println()
+			}
+			printIssueDetail(issue)
+		}
+	}
+
+	if len(missing) > 0 {
+		code := ExitPartial
+		if len(issues) == 0 {
+			code = ExitNotFound
+		}
+		return NewExitError(code, fmt.Errorf("issue not found: %s", strings.Join(missing, ", ")))
+	}
+	return nil
+}
+
+// fetchIssueDetailsByKeys fetches issues by key, 50 keys per search, in the
+// order requested. It also returns the keys that match no issue. A key not
+// returned by the search may belong to an issue that has since moved, which
+// the search returns under its new key, so such keys are looked up directly
+// before being reported.
+func fetchIssueDetailsByKeys(ctx context.Context, client *api.Client, keys, customFields []string, commentCount int) ([]*IssueDetail, []string, error) {
+	var issues []*IssueDetail
+	for batch := range slices.Chunk(keys, 50) {
+		found, err := searchIssueDetails(ctx, client, keysJQL(batch), customFields, commentCount, 0)
+		if err != nil {
+			return nil, nil, err
+		}
+		issues = append(issues, found...)
+	}
+
+	ordered, unmatched := orderIssuesByKeys(issues, keys)
+	requested := make(map[string]bool, len(keys))
+	for _, k := range keys {
+		requested[strings.ToUpper(k)] = true
+	}
+	moved := false
+	for _, issue := range ordered {
+		if !requested[issue.Key] {
+			moved = true
+			break
+		}
+	}
+	if !moved {
+		return ordered, unmatched, nil
+	}
+
+	var missing []string
+	for _, key := range unmatched {
+		_, err := client.Get(ctx, fmt.Sprintf("/issue/%s?fields=summary", url.PathEscape(key)))
+		var apiErr *api.APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			missing = append(missing, key)
+		} else if err != nil {
+			return nil, nil, err
+		}
+	}
+	return ordered, missing, nil
+}
+
+// keysJQL builds a JQL query matching the given issue keys.
+func keysJQL(keys []string) string {
+	quoted := make([]string, len(keys))
+	for i, k := range keys {
+		quoted[i] = `"` + k + `"`
+	}
+	return fmt.Sprintf("key in (%s)", strings.Join(quoted, ", "))
+}
+
+// orderIssuesByKeys returns issues in the order their keys were requested,
+// dropping duplicates, and the upper-case keys no issue was returned for.
+// Issues returned under another key, for example after a move, are appended
+// at the end.
+func orderIssuesByKeys(issues []*IssueDetail, keys []string) ([]*IssueDetail, []string) {
+	byKey := make(map[string]*IssueDetail, len(issues))
+	for _, issue := range issues {
+		byKey[issue.Key] = issue
+	}
+
+	ordered := make([]*IssueDetail, 0, len(issues))
+	var unmatched []string
+	seen := make(map[string]bool, len(keys))
+	for _, k := range keys {
+		k = strings.ToUpper(k)
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+		if issue, ok := byKey[k]; ok {
+			ordered = append(ordered, issue)
+			delete(byKey, k)
+		} else {
+			unmatched = append(unmatched, k)
+		}
+	}
+	for _, issue := range issues {
+		if _, ok := byKey[issue.Key]; ok {
+			ordered = append(ordered, issue)
+		}
+	}
+	return ordered, unmatched
+}
+
+// searchIssueDetails fetches full issue details for a JQL query. Comments
+// come from the inline comment field, newest first. A limit of 0 returns
+// all matches.
+func searchIssueDetails(ctx context.Context, client *api.Client, jql string, customFields []string, commentCount, limit int) ([]*IssueDetail, error) {
+	issues := []*IssueDetail{}
+	maxResults := 50
+	if limit > 0 && limit < maxResults {
+		maxResults = limit
+	}
+
+	nextPageToken := ""
+	const maxPages = 100
+
+	for range maxPages {
+		path := fmt.Sprintf("/search/jql?jql=%s&maxResults=%d&fields=*all&expand=names",
+			url.QueryEscape(jql), maxResults)
+		if nextPageToken != "" {
+			path += "&nextPageToken=" + url.QueryEscape(nextPageToken)
+		}
+
+		body, err := client.Get(ctx, path)
+		if err != nil {
+			return nil, err
+		}
+
+		var resp issueDetailSearchResponse
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return nil, fmt.Errorf("failed to parse response: %w", err)
+		}
+
+		for _, r := range resp.Issues {
+			detail, err := issueDetailFromResponse(r, resp.Names, customFields)
+			if err != nil {
+				return nil, err
+			}
+			if c := r.Fields.Comment; c != nil && commentCount > 0 {
+				start := max(len(c.Comments)-commentCount, 0)
+				for i := len(c.Comments) - 1; i >= start; i-- {
+					detail.Comments = append(detail.Comments, commentInfoFromValue(c.Comments[i]))
+				}
+				detail.TotalComments = c.Total
+			}
+			issues = append(issues, detail)
+
+			if limit > 0 && len(issues) >= limit {
+				return issues, nil
+			}
+		}
+
+		if resp.IsLast || resp.NextPageToken == "" {
+			break
+		}
+		nextPageToken = resp.NextPageToken
+	}
+
+	return issues, nil
+}
+
+// parseViewFields splits a --fields value into names. Returns nil when empty.
+func parseViewFields(value string) []string {
+	var names []string
+	for _, name := range strings.Split(value, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
 func getIssue(ctx context.Context, client *api.Client, key string) (*IssueDetail, error) {
-	path := fmt.Sprintf("/issue/%s", key)
+	return getIssueWithFields(ctx, client, key, nil)
+}
+
+// getIssueWithFields fetches an issue and renders the requested custom
+// fields. A single "all" selects every custom field that has a value.
+func getIssueWithFields(ctx context.Context, client *api.Client, key string, customFields []string) (*IssueDetail, error) {
+	path := fmt.Sprintf("/issue/%s?expand=names", key)
 
 	body, err := client.Get(ctx, path)
 	if err != nil {
@@ -212,12 +543,23 @@ func getIssue(ctx context.Context, client *api.Client, key string) (*IssueDetail
 		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
 
+	return issueDetailFromResponse(resp, resp.Names, customFields)
+}
+
+// issueDetailFromResponse converts an issue API response to IssueDetail.
+// Names maps field IDs to display names for the Sprint and custom fields.
+func issueDetailFromResponse(resp issueDetailResponse, names map[string]string, customFields []string) (*IssueDetail, error) {
 	detail := &IssueDetail{
 		Key:     resp.Key,
 		Summary: resp.Fields.Summary,
 		Created: resp.Fields.Created,
 		Updated: resp.Fields.Updated,
 		Labels:  resp.Fields.Labels,
+		DueDate: resp.Fields.DueDate,
+	}
+
+	if tt := resp.Fields.TimeTracking; tt != nil && *tt != (TimeTracking{}) {
+		detail.TimeTracking = tt
 	}
 
 	if resp.Fields.Status != nil {
@@ -238,6 +580,31 @@ func getIssue(ctx context.Context, client *api.Client, key string) (*IssueDetail
 	if resp.Fields.Project != nil {
 		detail.Project = resp.Fields.Project.Key
 	}
+	if resp.Fields.Parent != nil {
+		parent := issueInfoFromValue(*resp.Fields.Parent)
+		detail.Parent = &parent
+	}
+	for _, s := range resp.Fields.Subtasks {
+		detail.Subtasks = append(detail.Subtasks, issueInfoFromValue(s))
+	}
+	for _, c := range resp.Fields.Components {
+		detail.Components = append(detail.Components, c.Name)
+	}
+	for _, v := range resp.Fields.FixVersions {
+		detail.FixVersions = append(detail.FixVersions, v.Name)
+	}
+	if resp.Fields.Resolution != nil {
+		detail.Resolution = resp.Fields.Resolution.Name
+	}
+	detail.Sprint = currentSprint(resp.Fields.Raw[fieldIDByNameMap(names, "Sprint")])
+
+	if len(customFields) > 0 {
+		fields, err := selectCustomFields(resp.Fields.Raw, names, customFields)
+		if err != nil {
+			return nil, err
+		}
+		detail.CustomFields = fields
+	}
 
 	// Convert description from ADF to Markdown
 	if len(resp.Fields.Description) > 0 && string(resp.Fields.Description) != "null" {
@@ -275,27 +642,145 @@ func getIssue(ctx context.Context, client *api.Client, key string) (*IssueDetail
 	return detail, nil
 }
 
+// fieldIDByNameMap returns the ID of the field with the given display name
+// from an expand=names map, or an empty string.
+func fieldIDByNameMap(names map[string]string, name string) string {
+	for id, n := range names {
+		if strings.EqualFold(n, name) {
+			return id
+		}
+	}
+	return ""
+}
+
+// currentSprint returns the active sprint's name, or the most recent sprint
+// when none is active.
+func currentSprint(raw json.RawMessage) string {
+	var sprints []sprintDetail
+	if err := json.Unmarshal(raw, &sprints); err != nil || len(sprints) == 0 {
+		return ""
+	}
+	for _, s := range sprints {
+		if s.State == "active" {
+			return s.Name
+		}
+	}
+	return sprints[len(sprints)-1].Name
+}
+
+// selectCustomFields renders the requested custom fields by name. With
+// "all", every custom field that has a value is returned, sorted by name.
+// Named fields are returned in the order given, even when empty.
+func selectCustomFields(raw map[string]json.RawMessage, names map[string]string, selection []string) ([]CustomField, error) {
+	if len(selection) == 1 && strings.EqualFold(selection[0], "all") {
+		var fields []CustomField
+		for id, value := range raw {
+			if !strings.HasPrefix(id, "customfield_") {
+				continue
+			}
+			if text := fieldText(value); text != "" {
+				fields = append(fields, CustomField{ID: id, Name: names[id], Value: text})
+			}
+		}
+		sort.Slice(fields, func(i, j int) bool {
+			if fields[i].Name != fields[j].Name {
+				return fields[i].Name < fields[j].Name
+			}
+			return fields[i].ID < fields[j].ID
+		})
+		return fields, nil
+	}
+
+	catalogue := make([]FieldInfo, 0, len(names))
+	for id, name := range names {
+		catalogue = append(catalogue, FieldInfo{ID: id, Name: name})
+	}
+	// Deterministic matching when two fields share a name.
+	sort.Slice(catalogue, func(i, j int) bool { return catalogue[i].ID < catalogue[j].ID })
+
+	fields := make([]CustomField, 0, len(selection))
+	for _, nameOrID := range selection {
+		f, ok := findField(catalogue, nameOrID)
+		if !ok {
+			return nil, fmt.Errorf("field not found: %s", nameOrID)
+		}
+		fields = append(fields, CustomField{ID: f.ID, Name: f.Name, Value: fieldText(raw[f.ID])})
+	}
+	return fields, nil
+}
+
+// printHeaderField prints a label and value of the issue header, with values
+// aligned after the longest label.
+func printHeaderField(label, value string) {
+	fmt.Printf("%-14s%s\n", label+":", value)
+}
+
 func printIssueDetail(issue *IssueDetail) {
 	fmt.Printf("%s: %s\n", issue.Key, issue.Summary)
 	fmt.Println(strings.Repeat("-", 60))
-	fmt.Printf("Project:  %s\n", issue.Project)
-	fmt.Printf("Type:     %s\n", issue.Type)
-	fmt.Printf("Status:   %s\n", issue.Status)
-	fmt.Printf("Priority: %s\n", issue.Priority)
+	printHeaderField("Project", issue.Project)
+	printHeaderField("Type", issue.Type)
+	printHeaderField("Status", issue.Status)
+	printHeaderField("Priority", issue.Priority)
 
 	assignee := issue.Assignee
 	if assignee == "" {
 		assignee = "Unassigned"
 	}
-	fmt.Printf("Assignee: %s\n", assignee)
-	fmt.Printf("Reporter: %s\n", issue.Reporter)
+	printHeaderField("Assignee", assignee)
+	printHeaderField("Reporter", issue.Reporter)
 
+	if issue.Parent != nil {
+		printHeaderField("Parent", fmt.Sprintf("%s %s", issue.Parent.Key, width.Truncate(issue.Parent.Summary, 50, "...")))
+	}
+	if issue.Resolution != "" {
+		printHeaderField("Resolved", issue.Resolution)
+	}
+	if issue.Sprint != "" {
+		printHeaderField("Sprint", issue.Sprint)
+	}
 	if len(issue.Labels) > 0 {
-		fmt.Printf("Labels:   %s\n", strings.Join(issue.Labels, ", "))
+		printHeaderField("Labels", strings.Join(issue.Labels, ", "))
+	}
+	if len(issue.Components) > 0 {
+		printHeaderField("Components", strings.Join(issue.Components, ", "))
+	}
+	if len(issue.FixVersions) > 0 {
+		printHeaderField("Fix Versions", strings.Join(issue.FixVersions, ", "))
 	}
 
-	fmt.Printf("Created:  %s\n", formatDateTime(issue.Created))
-	fmt.Printf("Updated:  %s\n", formatDateTime(issue.Updated))
+	if issue.DueDate != "" {
+		printHeaderField("Due", issue.DueDate)
+	}
+	if tt := issue.TimeTracking; tt != nil {
+		orDash := func(s string) string {
+			if s == "" {
+				return "-"
+			}
+			return s
+		}
+		printHeaderField("Time", fmt.Sprintf("%s estimated, %s remaining, %s logged",
+			orDash(tt.OriginalEstimate), orDash(tt.RemainingEstimate), orDash(tt.TimeSpent)))
+	}
+
+	printHeaderField("Created", formatDateTime(issue.Created))
+	printHeaderField("Updated", formatDateTime(issue.Updated))
+
+	if len(issue.CustomFields) > 0 {
+		fmt.Println()
+		fmt.Println("Fields:")
+		for _, f := range issue.CustomFields {
+			switch {
+			case f.Value == "":
+				fmt.Printf("  %s: -\n", f.Name)
+			case strings.Contains(f.Value, "\n"):
+				fmt.Printf("  %s:\n", f.Name)
+				fmt.Print(RenderMarkdown(f.Value))
+			default:
+				fmt.Printf("  %s: %s\n", f.Name, f.Value)
+			}
+		}
+	}
 
 	if issue.Description != "" {
 		fmt.Println()
@@ -303,6 +788,15 @@ func printIssueDetail(issue *IssueDetail) {
 		fmt.Print(RenderMarkdown(issue.Description))
 	}
 
+	if len(issue.Subtasks) > 0 {
+		fmt.Println()
+		fmt.Printf("Subtasks (%d):\n", len(issue.Subtasks))
+		for _, s := range issue.Subtasks {
+			summary := width.Truncate(s.Summary, 50, "...")
+			fmt.Printf("  %s (%s) - %s\n", s.Key, s.Status, summary)
+		}
+	}
+
 	if len(issue.Links) > 0 {
 		fmt.Println()
 		fmt.Println("Links:")
@@ -330,12 +824,28 @@ func printIssueDetail(issue *IssueDetail) {
 		}
 		for _, c := range issue.Comments {
 			fmt.Println()
-			fmt.Printf("[%s] [%s] %s:\n", formatDateTime(c.Created), c.ID, c.Author)
+			fmt.Printf("[%s] [%s] %s%s:\n", formatDateTime(c.Created), c.ID, c.Author, commentRestriction(c))
 			fmt.Print(RenderMarkdown(c.Body))
 		}
 	}
 }
 
+// commentRestriction describes who can see a restricted comment, for
+// display after the author.
+func commentRestriction(c CommentInfo) string {
+	var parts []string
+	if c.Internal {
+		parts = append(parts, "internal")
+	}
+	if c.Visibility != "" {
+		parts = append(parts, c.Visibility)
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return " (" + strings.Join(parts, ", ") + ")"
+}
+
 // formatDateTime formats an ISO datetime string for display.
 func formatDateTime(iso string) string {
 	// Jira returns ISO 8601 format like "2024-01-16T14:30:00.000+0000"
@@ -349,7 +859,7 @@ func formatDateTime(iso string) string {
 // getComments fetches recent comments for an issue.
 // Returns the comments slice and the total count of comments on the issue.
 func getComments(ctx context.Context, client *api.Client, key string, limit int) ([]CommentInfo, int, error) {
-	path := fmt.Sprintf("/issue/%s/comment?maxResults=%d&orderBy=-created", key, limit)
+	path := fmt.Sprintf("/issue/%s/comment?maxResults=%d&orderBy=-created&expand=properties", key, limit)
 
 	body, err := client.Get(ctx, path)
 	if err != nil {
@@ -363,28 +873,47 @@ func getComments(ctx context.Context, client *api.Client, key string, limit int)
 
 	comments := make([]CommentInfo, 0, len(resp.Comments))
 	for _, c := range resp.Comments {
-		info := CommentInfo{
-			ID:      c.ID,
-			Created: c.Created,
-		}
-		if c.Author != nil {
-			info.Author = c.Author.DisplayName
+		comments = append(comments, commentInfoFromValue(c))
+	}
+
+	return comments, resp.Total, nil
+}
+
+// commentInfoFromValue converts a comment API value, rendering its body
+// as Markdown.
+func commentInfoFromValue(c commentValue) CommentInfo {
+	info := CommentInfo{
+		ID:      c.ID,
+		Created: c.Created,
+	}
+	if c.Author != nil {
+		info.Author = c.Author.DisplayName
+	}
+	if c.Visibility != nil {
+		info.Visibility = c.Visibility.Type + ":" + c.Visibility.Value
+	}
+	for _, p := range c.Properties {
+		if p.Key == internalCommentProperty {
+			var v struct {
+				Internal bool `json:"internal"`
+			}
+			if json.Unmarshal(p.Value, &v) == nil {
+				info.Internal = v.Internal
+			}
 		}
-		// Convert comment body from ADF to Markdown
-		if len(c.Body) > 0 && string(c.Body) != "null" {
-			md, err := converter.ADFToMarkdown(c.Body)
-			if err != nil {
-				// Non-fatal: use raw JSON as fallback
-				if Verbose() {
-					fmt.Fprintf(os.Stderr, "warning: failed to convert comment body: %v\n", err)
-				}
-				info.Body = string(c.Body)
-			} else {
-				info.Body = md
+	}
+	// Convert comment body from ADF to Markdown
+	if len(c.Body) > 0 && string(c.Body) != "null" {
+		md, err := converter.ADFToMarkdown(c.Body)
+		if err != nil {
+			// Non-fatal: use raw JSON as fallback
+			if Verbose() {
+				fmt.Fprintf(os.Stderr, "warning: failed to convert comment body: %v\n", err)
 			}
+			info.Body = string(c.Body)
+		} else {
+			info.Body = md
 		}
-		comments = append(comments, info)
 	}
-
-	return comments, resp.Total, nil
+	return info
 }
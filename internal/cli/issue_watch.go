@@ -30,8 +30,9 @@ var issueWatchCmd = &cobra.Command{
 		}
 		return nil
 	},
-	SilenceUsage: true,
-	RunE:         runIssueWatch,
+	SilenceUsage:      true,
+	ValidArgsFunction: completeIssueKeys,
+	RunE:              runIssueWatch,
 }
 
 var issueUnwatchCmd = &cobra.Command{
@@ -52,8 +53,9 @@ var issueUnwatchCmd = &cobra.Command{
 		}
 		return nil
 	},
-	SilenceUsage: true,
-	RunE:         runIssueUnwatch,
+	SilenceUsage:      true,
+	ValidArgsFunction: completeIssueKeys,
+	RunE:              runIssueUnwatch,
 }
 
 var unwatchStdin bool
@@ -87,6 +89,9 @@ func runIssueWatch(cmd *cobra.Command, args []string) error {
 		if err != nil {
 			return err
 		}
+		if err := checkBatchPolicy(len(issueKeys)); err != nil {
+			return err
+		}
 		if len(issueKeys) == 0 {
 			return fmt.Errorf("no issue keys provided via stdin")
 		}
@@ -124,7 +129,7 @@ func runIssueWatch(cmd *cobra.Command, args []string) error {
 	for _, key := range issueKeys {
 		err := addWatcher(ctx, client, key, accountID)
 		if err != nil {
-			results = append(results, BatchResult{Key: key, Success: false, Error: err.Error()})
+			results = append(results, failedResult(key, err))
 		} else {
 			results = append(results, BatchResult{Key: key, Success: true})
 		}
@@ -155,6 +160,9 @@ func runIssueUnwatch(cmd *cobra.Command, args []string) error {
 		if err != nil {
 			return err
 		}
+		if err := checkBatchPolicy(len(issueKeys)); err != nil {
+			return err
+		}
 		if len(issueKeys) == 0 {
 			return fmt.Errorf("no issue keys provided via stdin")
 		}
@@ -192,7 +200,7 @@ func runIssueUnwatch(cmd *cobra.Command, args []string) error {
 	for _, key := range issueKeys {
 		err := removeWatcher(ctx, client, key, accountID)
 		if err != nil {
-			results = append(results, BatchResult{Key: key, Success: false, Error: err.Error()})
+			results = append(results, failedResult(key, err))
 		} else {
 			results = append(results, BatchResult{Key: key, Success: true})
 		}
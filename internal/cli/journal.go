@@ -0,0 +1,157 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/grantcarthew/ajira/internal/api"
+	"github.com/grantcarthew/ajira/internal/journal"
+	"github.com/spf13/cobra"
+)
+
+// journalRun identifies the entries recorded by this invocation, so undo can
+// revert a whole command such as a bulk edit.
+var journalRun = journal.NewID()
+
+// journaling is true once the journal recorder is registered.
+var journaling bool
+
+type journalContextKey struct{}
+
+// journalInfo is attached to a request context to describe the change for
+// the journal. client, when set, is used to fetch the values the change left
+// on the issue.
+type journalInfo struct {
+	undo    *journal.Undo
+	client  *api.Client
+	reverts string
+}
+
+// withUndo records how to revert the requests made with ctx.
+func withUndo(ctx context.Context, undo *journal.Undo) context.Context {
+	return context.WithValue(ctx, journalContextKey{}, journalInfo{undo: undo})
+}
+
+// withFieldUndo records how to revert the requests made with ctx, and
+// fetches the changed fields again after each request so undo can detect
+// later changes.
+func withFieldUndo(ctx context.Context, client *api.Client, undo *journal.Undo) context.Context {
+	return context.WithValue(ctx, journalContextKey{}, journalInfo{undo: undo, client: client})
+}
+
+// withReverts marks the requests made with ctx as reverting entry id.
+func withReverts(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, journalContextKey{}, journalInfo{reverts: id})
+}
+
+// enableJournal records every mutating request made by cmd. A failure to
+// write the journal is a warning, since the change has already been made.
+func enableJournal(cmd *cobra.Command) {
+	path, err := journal.Path()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		return
+	}
+	if path == "" {
+		return
+	}
+
+	journaling = true
+	command := commandName(cmd)
+	api.SetRecorder(func(ctx context.Context, method, reqPath string, body []byte) {
+		info, _ := ctx.Value(journalContextKey{}).(journalInfo)
+		if info.undo != nil && info.client != nil {
+			info.undo = recordAfter(ctx, info.client, info.undo)
+		}
+		entry := journal.Entry{
+			ID:      journal.NewID(),
+			Run:     journalRun,
+			Time:    time.Now().UTC(),
+			Command: command,
+			Method:  method,
+			Path:    reqPath,
+			Undo:    info.undo,
+			Reverts: info.reverts,
+		}
+		if len(body) > 0 && json.Valid(body) {
+			entry.Body = body
+		}
+		if err := journal.Append(path, entry); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		}
+	})
+}
+
+// undoContext snapshots fields on an issue before a change to them and
+// returns ctx carrying the undo record. raw is passed to snapshotFields.
+func undoContext(ctx context.Context, client *api.Client, kind, key string, ids []string, raw map[string]json.RawMessage) (context.Context, error) {
+	if !journaling {
+		return ctx, nil
+	}
+	before, err := snapshotFields(ctx, client, key, ids, raw)
+	if err != nil {
+		return nil, err
+	}
+	return withFieldUndo(ctx, client, &journal.Undo{Kind: kind, Issue: key, Before: before}), nil
+}
+
+// recordAfter returns a copy of undo with the current values of its saved
+// fields. Without them the change cannot be undone, since undo would have
+// nothing to check later changes against.
+func recordAfter(ctx context.Context, client *api.Client, undo *journal.Undo) *journal.Undo {
+	ids := make([]string, 0, len(undo.Before))
+	for id := range undo.Before {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	after, err := snapshotFields(ctx, client, undo.Issue, ids, nil)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %s: change cannot be undone: %v\n", undo.Issue, err)
+		return undo
+	}
+	u := *undo
+	u.After = after
+	return &u
+}
+
+// snapshotFields returns the current values of fields on an issue, for the
+// journal. raw holds values already fetched, such as by a --jql search; the
+// issue is only fetched if a field is missing from it.
+func snapshotFields(ctx context.Context, client *api.Client, key string, ids []string, raw map[string]json.RawMessage) (map[string]json.RawMessage, error) {
+	missing := false
+	for _, id := range ids {
+		if _, ok := raw[id]; !ok {
+			missing = true
+			break
+		}
+	}
+
+	if missing {
+		body, err := client.Get(ctx, fmt.Sprintf("/issue/%s?fields=%s", url.PathEscape(key), url.QueryEscape(strings.Join(ids, ","))))
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch current values: %w", err)
+		}
+		var issue issueValue
+		if err := json.Unmarshal(body, &issue); err != nil {
+			return nil, fmt.Errorf("failed to parse response: %w", err)
+		}
+		raw = issue.Fields.Raw
+	}
+
+	before := make(map[string]json.RawMessage, len(ids))
+	for _, id := range ids {
+		if v, ok := raw[id]; ok {
+			before[id] = v
+		} else {
+			before[id] = json.RawMessage("null")
+		}
+	}
+	return before, nil
+}
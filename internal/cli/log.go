@@ -0,0 +1,121 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/grantcarthew/ajira/internal/journal"
+	"github.com/spf13/cobra"
+)
+
+// LogEntry is a journal entry as listed by ajira log.
+type LogEntry struct {
+	journal.Entry
+	Issue  string `json:"issue,omitempty"`
+	Undone bool   `json:"undone"`
+}
+
+var logLimit int
+
+var logCmd = &cobra.Command{
+	Use:   "log",
+	Short: "List recent changes",
+	Long:  "List the changes ajira has made, newest first, from the local journal. Use an entry ID with 'ajira undo --id'.",
+	Example: `  ajira log                     # Last 20 changes
+  ajira log -l 0                # Every change
+  ajira log --json              # Entries with request bodies and undo data`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE:         runLog,
+}
+
+func init() {
+	logCmd.Flags().IntVarP(&logLimit, "limit", "l", 20, "Maximum entries to show (0 = all)")
+	rootCmd.AddCommand(logCmd)
+}
+
+func runLog(cmd *cobra.Command, args []string) error {
+	entries, err := readJournal()
+	if err != nil {
+		return err
+	}
+
+	reverted := journal.Reverted(entries)
+	var log []LogEntry
+	for i := len(entries) - 1; i >= 0; i-- {
+		if logLimit > 0 && len(log) == logLimit {
+			break
+		}
+		e := entries[i]
+		log = append(log, LogEntry{Entry: e, Issue: entryIssue(e), Undone: reverted[e.ID]})
+	}
+
+	if JSONOutput() {
+		if log == nil {
+			log = []LogEntry{}
+		}
+		output, err := json.MarshalIndent(log, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to format JSON: %w", err)
+		}
+		fmt.Println(string(output))
+		return nil
+	}
+
+	if len(log) == 0 {
+		fmt.Println("No changes recorded.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tTIME\tCOMMAND\tISSUE\tREQUEST\tSTATE")
+	for _, e := range log {
+		state := ""
+		switch {
+		case e.Reverts != "":
+			state = "undo of " + e.Reverts
+		case e.Undone:
+			state = "undone"
+		case e.Undo == nil:
+			state = "not undoable"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s %s\t%s\n", e.ID, e.Time.Local().Format("2006-01-02 15:04"),
+			e.Command, e.Issue, e.Method, strings.TrimPrefix(e.Path, "/rest/api/3"), state)
+	}
+	w.Flush()
+
+	return nil
+}
+
+// readJournal returns every journal entry, oldest first.
+func readJournal() ([]journal.Entry, error) {
+	path, err := journal.Path()
+	if err != nil {
+		return nil, err
+	}
+	if path == "" {
+		return nil, fmt.Errorf("journal is disabled (AJIRA_JOURNAL=off)")
+	}
+	return journal.Read(path)
+}
+
+// entryIssue returns the issue an entry changed, from its undo record or
+// its request path.
+func entryIssue(e journal.Entry) string {
+	if e.Undo != nil {
+		return e.Undo.Issue
+	}
+	_, rest, ok := strings.Cut(e.Path, "/issue/")
+	if !ok {
+		return ""
+	}
+	key, _, _ := strings.Cut(rest, "/")
+	key, _, _ = strings.Cut(key, "?")
+	if !strings.Contains(key, "-") {
+		return ""
+	}
+	return key
+}
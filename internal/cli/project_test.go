@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grantcarthew/ajira/internal/api"
+)
+
+func TestGetProjectView(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/api/3/project/GCP":
+			if r.URL.Query().Get("expand") != "description,lead,issueTypes" {
+				t.Errorf("unexpected expand %q", r.URL.Query().Get("expand"))
+			}
+			_, _ = w.Write([]byte(`{
+				"id":"10000","key":"GCP","name":"Cloud Platform","description":"Infra",
+				"lead":{"displayName":"Jane"},"projectTypeKey":"software","style":"next-gen","simplified":true,
+				"projectCategory":{"name":"Engineering"},
+				"issueTypes":[
+					{"id":"1","name":"Task","hierarchyLevel":0},
+					{"id":"2","name":"Sub-task","hierarchyLevel":-1,"subtask":true},
+					{"id":"3","name":"Epic","hierarchyLevel":1}
+				],
+				"components":[{"id":"20","name":"Backend","lead":{"displayName":"Sam"},"assigneeType":"PROJECT_DEFAULT"}],
+				"versions":[{"id":"30","name":"1.0","released":true,"releaseDate":"2026-01-10"}]
+			}`))
+		case "/rest/api/3/project/GCP/statuses":
+			_, _ = w.Write([]byte(`[
+				{"id":"1","name":"Task","statuses":[{"id":"10","name":"To Do","statusCategory":{"key":"new","name":"To Do"}},{"id":"11","name":"Done","statusCategory":{"key":"done","name":"Done"}}]},
+				{"id":"3","name":"Epic","statuses":[{"id":"10","name":"To Do","statusCategory":{"key":"new","name":"To Do"}}]}
+			]`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	view, err := getProjectView(context.Background(), client, "https://example.atlassian.net", "GCP")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if view.Lead != "Jane" || view.Category != "Engineering" || view.Managed != "team" || view.ProjectType != "software" {
+		t.Errorf("unexpected project details: %+v", view)
+	}
+	if view.URL != "https://example.atlassian.net/browse/GCP" {
+		t.Errorf("unexpected URL %s", view.URL)
+	}
+	if len(view.IssueTypes) != 3 || view.IssueTypes[0].Name != "Epic" || view.IssueTypes[2].Name != "Sub-task" {
+		t.Errorf("expected issue types ordered by hierarchy level, got %+v", view.IssueTypes)
+	}
+	if len(view.Statuses) != 2 {
+		t.Errorf("expected 2 deduplicated statuses, got %+v", view.Statuses)
+	}
+	if len(view.Components) != 1 || view.Components[0].Lead != "Sam" {
+		t.Errorf("unexpected components: %+v", view.Components)
+	}
+	if len(view.Versions) != 1 || !view.Versions[0].Released {
+		t.Errorf("unexpected versions: %+v", view.Versions)
+	}
+}
+
+func TestGetProjectView_CompanyManaged(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/api/3/project/OPS":
+			_, _ = w.Write([]byte(`{"id":"1","key":"OPS","name":"Ops","style":"classic"}`))
+		case "/rest/api/3/project/OPS/statuses":
+			_, _ = w.Write([]byte(`[]`))
+		}
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	view, err := getProjectView(context.Background(), client, "", "OPS")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if view.Managed != "company" {
+		t.Errorf("expected company-managed, got %q", view.Managed)
+	}
+	if view.IssueTypes == nil || view.Statuses == nil || view.Components == nil || view.Versions == nil {
+		t.Error("expected empty slices rather than nil for JSON output")
+	}
+}
@@ -0,0 +1,261 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/fatih/color"
+	"github.com/grantcarthew/ajira/internal/api"
+	"github.com/grantcarthew/ajira/internal/config"
+	"github.com/grantcarthew/ajira/internal/jira"
+	"github.com/spf13/cobra"
+)
+
+// ProjectView is a one-shot overview of a project's configuration.
+type ProjectView struct {
+	ID          string             `json:"id"`
+	Key         string             `json:"key"`
+	Name        string             `json:"name"`
+	Description string             `json:"description,omitempty"`
+	Lead        string             `json:"lead"`
+	ProjectType string             `json:"projectType"`
+	Style       string             `json:"style"`
+	Managed     string             `json:"managed"`
+	Category    string             `json:"category,omitempty"`
+	URL         string             `json:"url"`
+	IssueTypes  []ProjectIssueType `json:"issueTypes"`
+	Statuses    []jira.Status      `json:"statuses"`
+	Components  []ComponentInfo    `json:"components"`
+	Versions    []ReleaseInfo      `json:"versions"`
+}
+
+// ProjectIssueType is an issue type with its hierarchy level: 1 for epics,
+// 0 for standard issues, and -1 for subtasks.
+type ProjectIssueType struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	HierarchyLevel int    `json:"hierarchyLevel"`
+	Subtask        bool   `json:"subtask"`
+}
+
+// projectDetailResponse matches the Jira project API response with
+// description, lead, and issue types expanded.
+type projectDetailResponse struct {
+	ID             string     `json:"id"`
+	Key            string     `json:"key"`
+	Name           string     `json:"name"`
+	Description    string     `json:"description"`
+	Lead           *userField `json:"lead"`
+	ProjectTypeKey string     `json:"projectTypeKey"`
+	Style          string     `json:"style"`
+	Simplified     bool       `json:"simplified"`
+	Category       *struct {
+		Name string `json:"name"`
+	} `json:"projectCategory"`
+	IssueTypes []struct {
+		ID             string `json:"id"`
+		Name           string `json:"name"`
+		HierarchyLevel int    `json:"hierarchyLevel"`
+		Subtask        bool   `json:"subtask"`
+	} `json:"issueTypes"`
+	Components []componentValue `json:"components"`
+	Versions   []releaseValue   `json:"versions"`
+}
+
+var projectViewCmd = &cobra.Command{
+	Use:   "view [project-key]",
+	Short: "View project details",
+	Long: `Display a project's lead, type, category, issue types with hierarchy levels, statuses, components, and versions.
+Defaults to -p or JIRA_PROJECT when no key is given.`,
+	Example: `  ajira project view GCP
+  ajira project view            # Uses JIRA_PROJECT
+  ajira project view GCP --json`,
+	Args:              cobra.MaximumNArgs(1),
+	SilenceUsage:      true,
+	ValidArgsFunction: completeFirstArg(completeProjects),
+	RunE:              runProjectView,
+}
+
+func init() {
+	projectCmd.AddCommand(projectViewCmd)
+}
+
+func runProjectView(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	projectKey := Project()
+	if len(args) > 0 {
+		projectKey = args[0]
+	}
+	if projectKey == "" {
+		return fmt.Errorf("project is required: pass a key, use -p flag, or set JIRA_PROJECT environment variable")
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(cfg)
+
+	view, err := getProjectView(ctx, client, cfg.BaseURL, projectKey)
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); ok {
+			return fmt.Errorf("API error: %w", apiErr)
+		}
+		return fmt.Errorf("failed to view project: %w", err)
+	}
+
+	if JSONOutput() {
+		output, err := json.MarshalIndent(view, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to format JSON: %w", err)
+		}
+		fmt.Println(string(output))
+	} else {
+		printProjectView(view)
+	}
+
+	return nil
+}
+
+func getProjectView(ctx context.Context, client *api.Client, baseURL, projectKey string) (*ProjectView, error) {
+	body, err := client.Get(ctx, fmt.Sprintf("/project/%s?expand=description,lead,issueTypes", url.PathEscape(projectKey)))
+	if err != nil {
+		return nil, err
+	}
+
+	var resp projectDetailResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	statuses, err := jira.GetStatuses(ctx, client, resp.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	view := &ProjectView{
+		ID:          resp.ID,
+		Key:         resp.Key,
+		Name:        resp.Name,
+		Description: resp.Description,
+		ProjectType: resp.ProjectTypeKey,
+		Style:       resp.Style,
+		Managed:     "company",
+		URL:         ProjectURL(baseURL, resp.Key),
+		IssueTypes:  []ProjectIssueType{},
+		Statuses:    statuses,
+		Components:  []ComponentInfo{},
+		Versions:    []ReleaseInfo{},
+	}
+	if resp.Simplified || resp.Style == "next-gen" {
+		view.Managed = "team"
+	}
+	if resp.Lead != nil {
+		view.Lead = resp.Lead.DisplayName
+	}
+	if resp.Category != nil {
+		view.Category = resp.Category.Name
+	}
+	if view.Statuses == nil {
+		view.Statuses = []jira.Status{}
+	}
+
+	for _, t := range resp.IssueTypes {
+		view.IssueTypes = append(view.IssueTypes, ProjectIssueType(t))
+	}
+	// Highest level first: epics, standard types, then subtasks.
+	slices.SortStableFunc(view.IssueTypes, func(a, b ProjectIssueType) int {
+		return b.HierarchyLevel - a.HierarchyLevel
+	})
+
+	for _, c := range resp.Components {
+		view.Components = append(view.Components, componentInfoFromValue(c))
+	}
+	for _, v := range resp.Versions {
+		view.Versions = append(view.Versions, ReleaseInfo(v))
+	}
+
+	return view, nil
+}
+
+func printProjectView(view *ProjectView) {
+	bold := color.New(color.Bold).SprintFunc()
+	header := color.New(color.FgCyan, color.Bold).SprintFunc()
+
+	orDash := func(s string) string {
+		if s == "" {
+			return "-"
+		}
+		return s
+	}
+
+	fmt.Printf("%s  %s\n", bold(view.Key), view.Name)
+	fmt.Printf("Lead:      %s\n", orDash(view.Lead))
+	fmt.Printf("Type:      %s (%s-managed)\n", orDash(view.ProjectType), view.Managed)
+	fmt.Printf("Category:  %s\n", orDash(view.Category))
+	fmt.Printf("URL:       %s\n", view.URL)
+	if view.Description != "" {
+		fmt.Println()
+		fmt.Println(view.Description)
+	}
+
+	fmt.Println()
+	fmt.Println(header("Issue Types"))
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, t := range view.IssueTypes {
+		level := "standard"
+		switch {
+		case t.Subtask || t.HierarchyLevel < 0:
+			level = "subtask"
+		case t.HierarchyLevel > 0:
+			level = fmt.Sprintf("level %d", t.HierarchyLevel)
+		}
+		fmt.Fprintf(w, "  %s\t%s\n", t.Name, level)
+	}
+	w.Flush()
+
+	fmt.Println()
+	fmt.Println(header("Statuses"))
+	for _, s := range view.Statuses {
+		fmt.Printf("  %s  (%s)\n", s.Name, s.Category)
+	}
+
+	fmt.Println()
+	fmt.Println(header("Components"))
+	if len(view.Components) == 0 {
+		fmt.Println("  None")
+	} else {
+		w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, c := range view.Components {
+			fmt.Fprintf(w, "  %s\t%s\n", c.Name, orDash(c.Lead))
+		}
+		w.Flush()
+	}
+
+	fmt.Println()
+	fmt.Println(header("Versions"))
+	if len(view.Versions) == 0 {
+		fmt.Println("  None")
+	} else {
+		w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, v := range view.Versions {
+			var flags []string
+			if v.Released {
+				flags = append(flags, "released")
+			}
+			if v.Archived {
+				flags = append(flags, "archived")
+			}
+			fmt.Fprintf(w, "  %s\t%s\t%s\n", v.Name, orDash(v.ReleaseDate), strings.Join(flags, ", "))
+		}
+		w.Flush()
+	}
+}
@@ -0,0 +1,387 @@
+package cli
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/grantcarthew/ajira/internal/api"
+	"github.com/grantcarthew/ajira/internal/config"
+	"github.com/spf13/cobra"
+)
+
+//go:embed templates/release_notes.md.tmpl
+var releaseNotesMarkdownTemplate string
+
+//go:embed templates/release_notes.html.tmpl
+var releaseNotesHTMLTemplate string
+
+// releaseNotesOther is the section for issues that match no group mapping.
+const releaseNotesOther = "Other"
+
+// ReleaseNotes is the data passed to release notes templates.
+type ReleaseNotes struct {
+	Project    string                `json:"project"`
+	Version    ReleaseInfo           `json:"version"`
+	GroupBy    string                `json:"groupBy"`
+	Total      int                   `json:"total"`
+	NotesTitle string                `json:"notesTitle,omitempty"`
+	Notes      []ReleaseNoteIssue    `json:"notes,omitempty"`
+	Sections   []ReleaseNotesSection `json:"sections"`
+}
+
+// ReleaseNotesSection is a titled group of issues.
+type ReleaseNotesSection struct {
+	Title  string             `json:"title"`
+	Issues []ReleaseNoteIssue `json:"issues"`
+}
+
+// ReleaseNoteIssue is an issue included in release notes. Note holds the
+// value of the designated notes field, if any.
+type ReleaseNoteIssue struct {
+	Key        string   `json:"key"`
+	Summary    string   `json:"summary"`
+	Type       string   `json:"type"`
+	Status     string   `json:"status"`
+	Labels     []string `json:"labels,omitempty"`
+	Components []string `json:"components,omitempty"`
+	Note       string   `json:"note,omitempty"`
+	URL        string   `json:"url"`
+}
+
+// releaseNotesOptions controls how release notes are grouped and which
+// field, if any, supplies per-issue notes.
+type releaseNotesOptions struct {
+	GroupBy    string
+	Mappings   []releaseNotesMapping
+	NotesField string
+}
+
+// releaseNotesMapping assigns issues with any of Values to a titled section.
+type releaseNotesMapping struct {
+	Title  string
+	Values []string
+}
+
+var (
+	releaseNotesGroupBy    string
+	releaseNotesMap        []string
+	releaseNotesFormat     string
+	releaseNotesTemplate   string
+	releaseNotesNotesField string
+)
+
+var releaseNotesCmd = &cobra.Command{
+	Use:   "notes <version>",
+	Short: "Generate release notes",
+	Long: `Generate release notes from the issues with the given fix version. Requires -p or JIRA_PROJECT.
+
+Issues are grouped by issue type, label, or component. With --group-by label or component, --map
+assigns values to named sections in the order given; unmatched issues go to "Other".
+--notes-field adds a section with each issue's value of that field (for example a "Release Note" field).
+--template renders a custom Go template; see the JSON output for the available data.`,
+	Example: `  ajira release notes 1.4.0                                  # Markdown grouped by type
+  ajira release notes 1.4.0 --format html > notes.html
+  ajira release notes 1.4.0 --group-by label --map "Features=feature,enhancement" --map "Fixes=bug"
+  ajira release notes 1.4.0 --notes-field "Release Note"
+  ajira release notes 1.4.0 --template notes.tmpl
+  ajira release notes 1.4.0 --json`,
+	Args:              cobra.ExactArgs(1),
+	SilenceUsage:      true,
+	ValidArgsFunction: completeFirstArg(completeVersions),
+	RunE:              runReleaseNotes,
+}
+
+func init() {
+	releaseNotesCmd.Flags().StringVar(&releaseNotesGroupBy, "group-by", "type", "Group issues by: type, label, component")
+	releaseNotesCmd.Flags().StringArrayVar(&releaseNotesMap, "map", nil, "Section mapping as Title=value,value (repeatable)")
+	releaseNotesCmd.Flags().StringVar(&releaseNotesFormat, "format", "markdown", "Output format: markdown, html, json")
+	releaseNotesCmd.Flags().StringVar(&releaseNotesTemplate, "template", "", "Go template file to render instead of the built-in template")
+	releaseNotesCmd.Flags().StringVar(&releaseNotesNotesField, "notes-field", "", "Field name or ID holding per-issue release notes")
+
+	releaseCmd.AddCommand(releaseNotesCmd)
+}
+
+func runReleaseNotes(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	versionName := args[0]
+
+	format := releaseNotesFormat
+	if JSONOutput() {
+		format = "json"
+	}
+	switch format {
+	case "markdown", "html", "json":
+	default:
+		return fmt.Errorf("invalid format: %s (valid: markdown, html, json)", format)
+	}
+	switch releaseNotesGroupBy {
+	case "type", "label", "component":
+	default:
+		return fmt.Errorf("invalid group-by: %s (valid: type, label, component)", releaseNotesGroupBy)
+	}
+
+	mappings, err := parseReleaseNotesMappings(releaseNotesMap)
+	if err != nil {
+		return err
+	}
+	if len(mappings) > 0 && releaseNotesGroupBy == "type" {
+		return fmt.Errorf("--map requires --group-by label or component")
+	}
+
+	tmplText := ""
+	if releaseNotesTemplate != "" {
+		data, err := os.ReadFile(releaseNotesTemplate)
+		if err != nil {
+			return fmt.Errorf("failed to read template: %w", err)
+		}
+		tmplText = string(data)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	projectKey := Project()
+	if projectKey == "" {
+		return fmt.Errorf("project is required: use -p flag or set JIRA_PROJECT environment variable")
+	}
+
+	client := api.NewClient(cfg)
+
+	opts := releaseNotesOptions{
+		GroupBy:    releaseNotesGroupBy,
+		Mappings:   mappings,
+		NotesField: releaseNotesNotesField,
+	}
+
+	notes, err := buildReleaseNotes(ctx, client, cfg.BaseURL, projectKey, versionName, opts)
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); ok {
+			return fmt.Errorf("API error: %w", apiErr)
+		}
+		return fmt.Errorf("failed to build release notes: %w", err)
+	}
+
+	if format == "json" {
+		output, err := json.MarshalIndent(notes, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to format JSON: %w", err)
+		}
+		fmt.Println(string(output))
+		return nil
+	}
+
+	return renderReleaseNotes(os.Stdout, notes, format, tmplText)
+}
+
+// parseReleaseNotesMappings parses --map values of the form Title=a,b,c.
+func parseReleaseNotesMappings(values []string) ([]releaseNotesMapping, error) {
+	mappings := make([]releaseNotesMapping, 0, len(values))
+	for _, v := range values {
+		title, list, ok := strings.Cut(v, "=")
+		title = strings.TrimSpace(title)
+		if !ok || title == "" || strings.TrimSpace(list) == "" {
+			return nil, fmt.Errorf("invalid mapping: %s (expected Title=value,value)", v)
+		}
+
+		m := releaseNotesMapping{Title: title}
+		for _, item := range strings.Split(list, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				m.Values = append(m.Values, item)
+			}
+		}
+		mappings = append(mappings, m)
+	}
+	return mappings, nil
+}
+
+// buildReleaseNotes resolves the version, fetches its issues, and groups them.
+func buildReleaseNotes(ctx context.Context, client *api.Client, baseURL, projectKey, versionName string, opts releaseNotesOptions) (*ReleaseNotes, error) {
+	releases, err := fetchAllReleases(ctx, client, projectKey, "", 0)
+	if err != nil {
+		return nil, err
+	}
+	i := slices.IndexFunc(releases, func(r ReleaseInfo) bool {
+		return r.Name == versionName || r.ID == versionName
+	})
+	if i < 0 {
+		return nil, fmt.Errorf("release not found: %s", versionName)
+	}
+	version := releases[i]
+
+	notes := &ReleaseNotes{
+		Project:  projectKey,
+		Version:  version,
+		GroupBy:  opts.GroupBy,
+		Sections: []ReleaseNotesSection{},
+	}
+
+	fields := "summary,status,issuetype,labels,components"
+	notesFieldID := ""
+	if opts.NotesField != "" {
+		all, err := fetchFields(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+		f, ok := findField(all, opts.NotesField)
+		if !ok {
+			return nil, fmt.Errorf("field not found: %s", opts.NotesField)
+		}
+		notesFieldID = f.ID
+		notes.NotesTitle = f.Name
+		fields += "," + f.ID
+	}
+
+	jql := fmt.Sprintf("project = %s AND fixVersion = %s ORDER BY issuetype ASC, key ASC", projectKey, version.ID)
+	values, err := searchIssueValues(ctx, client, jql, fields, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	issues := make([]ReleaseNoteIssue, len(values))
+	for i, v := range values {
+		issues[i] = releaseNoteIssueFrom(v, baseURL, notesFieldID)
+		if issues[i].Note != "" {
+			notes.Notes = append(notes.Notes, issues[i])
+		}
+	}
+
+	notes.Total = len(issues)
+	notes.Sections = groupReleaseNoteIssues(issues, opts.GroupBy, opts.Mappings)
+	return notes, nil
+}
+
+func releaseNoteIssueFrom(v issueValue, baseURL, notesFieldID string) ReleaseNoteIssue {
+	info := issueInfoFromValue(v)
+	issue := ReleaseNoteIssue{
+		Key:     info.Key,
+		Summary: info.Summary,
+		Type:    info.Type,
+		Status:  info.Status,
+		URL:     IssueURL(baseURL, info.Key),
+	}
+
+	_ = json.Unmarshal(v.Fields.Raw["labels"], &issue.Labels)
+
+	var components []struct {
+		Name string `json:"name"`
+	}
+	_ = json.Unmarshal(v.Fields.Raw["components"], &components)
+	for _, c := range components {
+		issue.Components = append(issue.Components, c.Name)
+	}
+
+	if notesFieldID != "" {
+		issue.Note = fieldText(v.Fields.Raw[notesFieldID])
+	}
+	return issue
+}
+
+// groupReleaseNoteIssues splits issues into sections. Each issue appears in
+// exactly one section. By type, sections follow first appearance. By label or
+// component, mapped sections come first in mapping order; without mappings
+// each value is its own section, sorted, using an issue's first value
+// alphabetically. Unmatched issues go to a trailing "Other" section.
+func groupReleaseNoteIssues(issues []ReleaseNoteIssue, groupBy string, mappings []releaseNotesMapping) []ReleaseNotesSection {
+	var titles []string
+	byTitle := make(map[string][]ReleaseNoteIssue)
+
+	for _, issue := range issues {
+		title := releaseNoteSectionOf(issue, groupBy, mappings)
+		if _, ok := byTitle[title]; !ok {
+			titles = append(titles, title)
+		}
+		byTitle[title] = append(byTitle[title], issue)
+	}
+
+	switch {
+	case groupBy == "type":
+	case len(mappings) > 0:
+		order := make(map[string]int, len(mappings))
+		for i, m := range mappings {
+			if _, ok := order[m.Title]; !ok {
+				order[m.Title] = i
+			}
+		}
+		order[releaseNotesOther] = len(mappings)
+		slices.SortStableFunc(titles, func(a, b string) int {
+			return order[a] - order[b]
+		})
+	default:
+		slices.SortFunc(titles, func(a, b string) int {
+			switch {
+			case a == releaseNotesOther:
+				return 1
+			case b == releaseNotesOther:
+				return -1
+			}
+			return strings.Compare(a, b)
+		})
+	}
+
+	sections := make([]ReleaseNotesSection, len(titles))
+	for i, title := range titles {
+		sections[i] = ReleaseNotesSection{Title: title, Issues: byTitle[title]}
+	}
+	return sections
+}
+
+func releaseNoteSectionOf(issue ReleaseNoteIssue, groupBy string, mappings []releaseNotesMapping) string {
+	var values []string
+	switch groupBy {
+	case "type":
+		if issue.Type == "" {
+			return releaseNotesOther
+		}
+		return issue.Type
+	case "label":
+		values = issue.Labels
+	case "component":
+		values = issue.Components
+	}
+
+	for _, m := range mappings {
+		for _, v := range values {
+			if slices.ContainsFunc(m.Values, func(s string) bool { return strings.EqualFold(s, v) }) {
+				return m.Title
+			}
+		}
+	}
+	if len(mappings) > 0 || len(values) == 0 {
+		return releaseNotesOther
+	}
+	return slices.Min(values)
+}
+
+// renderReleaseNotes executes the built-in template for format, or tmplText
+// if set. HTML output is escaped with html/template.
+func renderReleaseNotes(w io.Writer, notes *ReleaseNotes, format, tmplText string) error {
+	if format == "html" {
+		if tmplText == "" {
+			tmplText = releaseNotesHTMLTemplate
+		}
+		tmpl, err := htmltemplate.New("release-notes").Parse(tmplText)
+		if err != nil {
+			return fmt.Errorf("failed to parse template: %w", err)
+		}
+		return tmpl.Execute(w, notes)
+	}
+
+	if tmplText == "" {
+		tmplText = releaseNotesMarkdownTemplate
+	}
+	tmpl, err := template.New("release-notes").Parse(tmplText)
+	if err != nil {
+		return fmt.Errorf("failed to parse template: %w", err)
+	}
+	return tmpl.Execute(w, notes)
+}
@@ -0,0 +1,221 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/grantcarthew/ajira/internal/api"
+)
+
+func TestParseReleaseNotesMappings(t *testing.T) {
+	mappings, err := parseReleaseNotesMappings([]string{"Features=feature, enhancement", "Fixes=bug"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(mappings) != 2 || mappings[0].Title != "Features" || len(mappings[0].Values) != 2 || mappings[0].Values[1] != "enhancement" {
+		t.Errorf("unexpected mappings: %+v", mappings)
+	}
+
+	for _, bad := range []string{"Features", "=bug", "Fixes="} {
+		if _, err := parseReleaseNotesMappings([]string{bad}); err == nil {
+			t.Errorf("expected error for %q", bad)
+		}
+	}
+}
+
+func TestGroupReleaseNoteIssues_ByType(t *testing.T) {
+	issues := []ReleaseNoteIssue{
+		{Key: "GCP-1", Type: "Bug"},
+		{Key: "GCP-2", Type: "Story"},
+		{Key: "GCP-3", Type: "Bug"},
+	}
+
+	sections := groupReleaseNoteIssues(issues, "type", nil)
+
+	if len(sections) != 2 || sections[0].Title != "Bug" || len(sections[0].Issues) != 2 || sections[1].Title != "Story" {
+		t.Errorf("unexpected sections: %+v", sections)
+	}
+}
+
+func TestGroupReleaseNoteIssues_LabelMapping(t *testing.T) {
+	issues := []ReleaseNoteIssue{
+		{Key: "GCP-1", Labels: []string{"bug"}},
+		{Key: "GCP-2", Labels: []string{"ui", "Enhancement"}},
+		{Key: "GCP-3"},
+		{Key: "GCP-4", Labels: []string{"feature", "bug"}},
+	}
+	mappings := []releaseNotesMapping{
+		{Title: "Features", Values: []string{"feature", "enhancement"}},
+		{Title: "Fixes", Values: []string{"bug"}},
+	}
+
+	sections := groupReleaseNoteIssues(issues, "label", mappings)
+
+	want := map[string][]string{
+		"Features": {"GCP-2", "GCP-4"},
+		"Fixes":    {"GCP-1"},
+		"Other":    {"GCP-3"},
+	}
+	if len(sections) != 3 || sections[0].Title != "Features" || sections[1].Title != "Fixes" || sections[2].Title != "Other" {
+		t.Fatalf("unexpected section order: %+v", sections)
+	}
+	for _, s := range sections {
+		var keys []string
+		for _, issue := range s.Issues {
+			keys = append(keys, issue.Key)
+		}
+		if strings.Join(keys, ",") != strings.Join(want[s.Title], ",") {
+			t.Errorf("section %s: expected %v, got %v", s.Title, want[s.Title], keys)
+		}
+	}
+}
+
+func TestGroupReleaseNoteIssues_ComponentsUnmapped(t *testing.T) {
+	issues := []ReleaseNoteIssue{
+		{Key: "GCP-1", Components: []string{"Web"}},
+		{Key: "GCP-2"},
+		{Key: "GCP-3", Components: []string{"Web", "API"}},
+	}
+
+	sections := groupReleaseNoteIssues(issues, "component", nil)
+
+	if len(sections) != 3 || sections[0].Title != "API" || sections[1].Title != "Web" || sections[2].Title != "Other" {
+		t.Errorf("unexpected sections: %+v", sections)
+	}
+}
+
+func TestBuildReleaseNotes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/api/3/project/GCP/version":
+			_, _ = w.Write([]byte(`{"isLast":true,"values":[{"id":"100","name":"1.4.0","released":true,"releaseDate":"2026-05-01","description":"Spring release"}]}`))
+		case "/rest/api/3/field":
+			_, _ = w.Write([]byte(`[{"id":"customfield_10100","name":"Release Note","custom":true}]`))
+		case "/rest/api/3/search/jql":
+			if jql := r.URL.Query().Get("jql"); !strings.Contains(jql, "fixVersion = 100") {
+				t.Errorf("expected fixVersion by ID, got %q", jql)
+			}
+			_, _ = w.Write([]byte(`{"isLast":true,"issues":[
+				{"key":"GCP-1","fields":{"summary":"Crash on <save>","issuetype":{"name":"Bug"},"status":{"name":"Done"},"labels":["bug"],"components":[{"name":"Web"}],"customfield_10100":null}},
+				{"key":"GCP-2","fields":{"summary":"Dark mode","issuetype":{"name":"Story"},"status":{"name":"Done"},"labels":[],"components":[],
+					"customfield_10100":{"type":"doc","version":1,"content":[{"type":"paragraph","content":[{"type":"text","text":"Themes are here."}]}]}}}
+			]}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	notes, err := buildReleaseNotes(context.Background(), client, "https://example.atlassian.net", "GCP", "1.4.0",
+		releaseNotesOptions{GroupBy: "type", NotesField: "Release Note"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if notes.Version.ReleaseDate != "2026-05-01" || notes.Total != 2 {
+		t.Errorf("unexpected notes header: %+v", notes)
+	}
+	if notes.NotesTitle != "Release Note" || len(notes.Notes) != 1 || notes.Notes[0].Note != "Themes are here." {
+		t.Errorf("unexpected notes section: %q %+v", notes.NotesTitle, notes.Notes)
+	}
+	if len(notes.Sections) != 2 || notes.Sections[0].Issues[0].Components[0] != "Web" {
+		t.Errorf("unexpected sections: %+v", notes.Sections)
+	}
+	if notes.Sections[0].Issues[0].URL != "https://example.atlassian.net/browse/GCP-1" {
+		t.Errorf("unexpected URL: %s", notes.Sections[0].Issues[0].URL)
+	}
+
+	_, err = buildReleaseNotes(context.Background(), client, "", "GCP", "9.9.9", releaseNotesOptions{GroupBy: "type"})
+	if err == nil || !strings.Contains(err.Error(), "release not found") {
+		t.Errorf("expected release not found error, got %v", err)
+	}
+}
+
+var sampleReleaseNotes = &ReleaseNotes{
+	Version:    ReleaseInfo{Name: "1.4.0", ReleaseDate: "2026-05-01"},
+	NotesTitle: "Release Note",
+	Notes:      []ReleaseNoteIssue{{Key: "GCP-2", Summary: "Dark mode", Note: "Themes are here."}},
+	Sections: []ReleaseNotesSection{
+		{Title: "Bug", Issues: []ReleaseNoteIssue{{Key: "GCP-1", Summary: "Crash on <save>", URL: "https://x/browse/GCP-1"}}},
+	},
+}
+
+func TestRenderReleaseNotes_Markdown(t *testing.T) {
+	var buf bytes.Buffer
+	if err := renderReleaseNotes(&buf, sampleReleaseNotes, "markdown", ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := `# 1.4.0 (2026-05-01)
+
+## Release Note
+
+### GCP-2: Dark mode
+
+Themes are here.
+
+## Bug
+
+- [GCP-1](https://x/browse/GCP-1) Crash on <save>
+`
+	if buf.String() != want {
+		t.Errorf("unexpected markdown:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
+
+func TestRenderReleaseNotes_HTMLEscapes(t *testing.T) {
+	var buf bytes.Buffer
+	if err := renderReleaseNotes(&buf, sampleReleaseNotes, "html", ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, "<h1>1.4.0 (2026-05-01)</h1>") {
+		t.Errorf("expected heading, got:\n%s", out)
+	}
+	if !strings.Contains(out, "Crash on &lt;save&gt;") {
+		t.Errorf("expected escaped summary, got:\n%s", out)
+	}
+}
+
+func TestRenderReleaseNotes_CustomTemplate(t *testing.T) {
+	var buf bytes.Buffer
+	tmpl := `{{range .Sections}}{{.Title}}:{{range .Issues}} {{.Key}}{{end}}{{end}}`
+	if err := renderReleaseNotes(&buf, sampleReleaseNotes, "markdown", tmpl); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if buf.String() != "Bug: GCP-1" {
+		t.Errorf("unexpected output: %q", buf.String())
+	}
+
+	if err := renderReleaseNotes(&buf, sampleReleaseNotes, "markdown", "{{.Missing"); err == nil {
+		t.Error("expected parse error")
+	}
+}
+
+func TestFieldText(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want string
+	}{
+		{`"plain"`, "plain"},
+		{`null`, ""},
+		{`{"value":"High"}`, "High"},
+		{`[{"value":"A"},{"value":"B"}]`, "A, B"},
+		{`{"displayName":"Jane"}`, "Jane"},
+		{`{"type":"doc","version":1,"content":[{"type":"paragraph","content":[{"type":"text","text":"Hi"}]}]}`, "Hi"},
+	}
+	for _, tt := range tests {
+		if got := fieldText(json.RawMessage(tt.raw)); got != tt.want {
+			t.Errorf("fieldText(%s) = %q, want %q", tt.raw, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,446 @@
+package cli
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/grantcarthew/ajira/internal/api"
+	"github.com/grantcarthew/ajira/internal/config"
+	"github.com/grantcarthew/ajira/internal/width"
+	"github.com/spf13/cobra"
+)
+
+// flowPercentiles are the percentiles reported for every duration metric.
+var flowPercentiles = []float64{50, 85, 95}
+
+// FlowReport holds delivery metrics for a set of issues. Durations are in days.
+type FlowReport struct {
+	Query        string            `json:"query"`
+	Lead         FlowStats         `json:"lead"`
+	Cycle        FlowStats         `json:"cycle"`
+	ByType       []FlowTypeStats   `json:"byType"`
+	TimeInStatus []FlowStatusStats `json:"timeInStatus"`
+	Issues       []FlowIssue       `json:"issues"`
+}
+
+// FlowStats summarises a duration distribution.
+type FlowStats struct {
+	Count int     `json:"count"`
+	Mean  float64 `json:"mean"`
+	P50   float64 `json:"p50"`
+	P85   float64 `json:"p85"`
+	P95   float64 `json:"p95"`
+}
+
+// FlowTypeStats holds lead and cycle time for one issue type.
+type FlowTypeStats struct {
+	Type  string    `json:"type"`
+	Lead  FlowStats `json:"lead"`
+	Cycle FlowStats `json:"cycle"`
+}
+
+// FlowStatusStats summarises time spent in one status across issues.
+type FlowStatusStats struct {
+	Status string `json:"status"`
+	FlowStats
+}
+
+// FlowIssue holds the per-issue flow metrics.
+type FlowIssue struct {
+	Key          string             `json:"key"`
+	Type         string             `json:"type"`
+	Status       string             `json:"status"`
+	Created      string             `json:"created"`
+	Started      string             `json:"started,omitempty"`
+	Completed    string             `json:"completed,omitempty"`
+	LeadDays     *float64           `json:"leadDays"`
+	CycleDays    *float64           `json:"cycleDays"`
+	TimeInStatus map[string]float64 `json:"timeInStatus"`
+}
+
+var (
+	flowQuery  string
+	flowLimit  int
+	flowFormat string
+)
+
+var reportFlowCmd = &cobra.Command{
+	Use:   "flow",
+	Short: "Lead time, cycle time, and time in status",
+	Long: `Compute flow metrics for issues matching a JQL query from their changelogs.
+Lead time runs from creation to done; cycle time from first entering an in-progress status to done.
+Time in status excludes time spent in done statuses. Durations are reported in days with p50, p85, and p95.`,
+	Example: `  ajira report flow -q "project = GCP AND resolved >= -30d"
+  ajira report flow -q "project = GCP AND type = Bug" -l 0 --format csv > bugs.csv
+  ajira report flow -q "sprint in closedSprints()" --json`,
+	SilenceUsage: true,
+	RunE:         runReportFlow,
+}
+
+func init() {
+	reportFlowCmd.Flags().StringVarP(&flowQuery, "query", "q", "", "JQL query selecting issues (required)")
+	reportFlowCmd.Flags().IntVarP(&flowLimit, "limit", "l", 200, "Maximum issues to analyse (0 = all)")
+	reportFlowCmd.Flags().StringVar(&flowFormat, "format", "text", "Output format: text, csv, json")
+
+	reportCmd.AddCommand(reportFlowCmd)
+}
+
+func runReportFlow(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	if flowQuery == "" {
+		return fmt.Errorf("--query is required")
+	}
+	format := flowFormat
+	if JSONOutput() {
+		format = "json"
+	}
+	switch format {
+	case "text", "csv", "json":
+	default:
+		return fmt.Errorf("invalid format: %s (valid: text, csv, json)", format)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(cfg)
+
+	report, err := buildFlowReport(ctx, client, flowQuery, flowLimit)
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); ok {
+			return fmt.Errorf("API error: %w", apiErr)
+		}
+		return fmt.Errorf("failed to compute flow metrics: %w", err)
+	}
+
+	switch format {
+	case "json":
+		output, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to format JSON: %w", err)
+		}
+		fmt.Println(string(output))
+	case "csv":
+		return writeFlowCSV(os.Stdout, report)
+	default:
+		printFlowReport(report)
+	}
+
+	return nil
+}
+
+// buildFlowReport fetches the matching issues and their changelogs and
+// computes flow metrics.
+func buildFlowReport(ctx context.Context, client *api.Client, jql string, limit int) (*FlowReport, error) {
+	issues, err := searchIssueValues(ctx, client, jql, "status,issuetype,created", limit)
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := fetchStatusCategories(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, len(issues))
+	for i, issue := range issues {
+		keys[i] = issue.Key
+	}
+	histories, err := fetchChangelogs(ctx, client, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	report := computeFlowReport(issues, histories, categories, time.Now())
+	report.Query = jql
+	return report, nil
+}
+
+// computeFlowReport derives per-issue metrics by replaying status changes,
+// then aggregates them overall, by issue type, and by status.
+func computeFlowReport(issues []issueValue, histories map[string][]changelogHistory, categories map[string]string, now time.Time) *FlowReport {
+	report := &FlowReport{
+		Issues:       []FlowIssue{},
+		ByType:       []FlowTypeStats{},
+		TimeInStatus: []FlowStatusStats{},
+	}
+
+	var lead, cycle []float64
+	typeLead := make(map[string][]float64)
+	typeCycle := make(map[string][]float64)
+	var types []string
+	statusDays := make(map[string][]float64)
+	var statuses []string
+
+	for _, issue := range issues {
+		fi := flowIssueFrom(issue, histories[issue.Key], categories, now)
+		report.Issues = append(report.Issues, fi)
+
+		if !slices.Contains(types, fi.Type) {
+			types = append(types, fi.Type)
+		}
+		if fi.LeadDays != nil {
+			lead = append(lead, *fi.LeadDays)
+			typeLead[fi.Type] = append(typeLead[fi.Type], *fi.LeadDays)
+		}
+		if fi.CycleDays != nil {
+			cycle = append(cycle, *fi.CycleDays)
+			typeCycle[fi.Type] = append(typeCycle[fi.Type], *fi.CycleDays)
+		}
+		for status, days := range fi.TimeInStatus {
+			if _, ok := statusDays[status]; !ok {
+				statuses = append(statuses, status)
+			}
+			statusDays[status] = append(statusDays[status], days)
+		}
+	}
+
+	report.Lead = flowStats(lead)
+	report.Cycle = flowStats(cycle)
+
+	slices.Sort(types)
+	for _, t := range types {
+		report.ByType = append(report.ByType, FlowTypeStats{
+			Type:  t,
+			Lead:  flowStats(typeLead[t]),
+			Cycle: flowStats(typeCycle[t]),
+		})
+	}
+
+	slices.Sort(statuses)
+	for _, s := range statuses {
+		report.TimeInStatus = append(report.TimeInStatus, FlowStatusStats{Status: s, FlowStats: flowStats(statusDays[s])})
+	}
+
+	return report
+}
+
+// flowIssueFrom replays an issue's status changes from creation to now.
+func flowIssueFrom(issue issueValue, histories []changelogHistory, categories map[string]string, now time.Time) FlowIssue {
+	info := issueInfoFromValue(issue)
+	fi := FlowIssue{
+		Key:          info.Key,
+		Type:         info.Type,
+		Status:       info.Status,
+		TimeInStatus: map[string]float64{},
+	}
+
+	var createdRaw string
+	_ = json.Unmarshal(issue.Fields.Raw["created"], &createdRaw)
+	created, err := parseJiraTime(createdRaw)
+	if err != nil {
+		return fi
+	}
+	fi.Created = created.Format(time.RFC3339)
+
+	changes := fieldChanges(histories, "status", "status")
+
+	// Walk status intervals, starting from the status the issue was created in.
+	statusID, statusName := "", info.Status
+	if issue.Fields.Status != nil {
+		statusID = issue.Fields.Status.ID
+	}
+	if len(changes) > 0 {
+		statusID, statusName = changes[0].From, changes[0].FromString
+	}
+
+	var started, completed time.Time
+	since := created
+	for _, c := range changes {
+		if categories[statusID] != "done" {
+			fi.TimeInStatus[statusName] += days(c.At.Sub(since))
+		}
+
+		switch categories[c.To] {
+		case "indeterminate":
+			if started.IsZero() {
+				started = c.At
+			}
+			completed = time.Time{}
+		case "done":
+			if categories[statusID] != "done" {
+				completed = c.At
+			}
+		default:
+			completed = time.Time{}
+		}
+
+		statusID, statusName, since = c.To, c.ToString, c.At
+	}
+	if categories[statusID] != "done" {
+		fi.TimeInStatus[statusName] += days(now.Sub(since))
+	}
+
+	for status, d := range fi.TimeInStatus {
+		fi.TimeInStatus[status] = round1(d)
+	}
+
+	if !started.IsZero() {
+		fi.Started = started.Format(time.RFC3339)
+	}
+	if !completed.IsZero() {
+		fi.Completed = completed.Format(time.RFC3339)
+		lead := round1(days(completed.Sub(created)))
+		fi.LeadDays = &lead
+		if !started.IsZero() && !started.After(completed) {
+			cycle := round1(days(completed.Sub(started)))
+			fi.CycleDays = &cycle
+		}
+	}
+
+	return fi
+}
+
+func days(d time.Duration) float64 {
+	return d.Hours() / 24
+}
+
+func round1(v float64) float64 {
+	return math.Round(v*10) / 10
+}
+
+func flowStats(values []float64) FlowStats {
+	if len(values) == 0 {
+		return FlowStats{}
+	}
+
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+
+	var sum float64
+	for _, v := range sorted {
+		sum += v
+	}
+
+	return FlowStats{
+		Count: len(sorted),
+		Mean:  round1(sum / float64(len(sorted))),
+		P50:   round1(percentile(sorted, flowPercentiles[0])),
+		P85:   round1(percentile(sorted, flowPercentiles[1])),
+		P95:   round1(percentile(sorted, flowPercentiles[2])),
+	}
+}
+
+// percentile returns the p-th percentile of sorted values using linear
+// interpolation between closest ranks.
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := p / 100 * float64(len(sorted)-1)
+	lo := int(math.Floor(rank))
+	hi := int(math.Ceil(rank))
+	return sorted[lo] + (sorted[hi]-sorted[lo])*(rank-float64(lo))
+}
+
+// writeFlowCSV writes one row per issue with a column per status.
+func writeFlowCSV(w io.Writer, report *FlowReport) error {
+	statuses := make([]string, len(report.TimeInStatus))
+	for i, s := range report.TimeInStatus {
+		statuses[i] = s.Status
+	}
+
+	cw := csv.NewWriter(w)
+	header := []string{"key", "type", "status", "created", "started", "completed", "lead_days", "cycle_days"}
+	for _, s := range statuses {
+		header = append(header, "days_in_"+s)
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	optional := func(v *float64) string {
+		if v == nil {
+			return ""
+		}
+		return strconv.FormatFloat(*v, 'f', -1, 64)
+	}
+
+	for _, fi := range report.Issues {
+		row := []string{fi.Key, fi.Type, fi.Status, fi.Created, fi.Started, fi.Completed, optional(fi.LeadDays), optional(fi.CycleDays)}
+		for _, s := range statuses {
+			d, ok := fi.TimeInStatus[s]
+			if ok {
+				row = append(row, strconv.FormatFloat(d, 'f', -1, 64))
+			} else {
+				row = append(row, "")
+			}
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+func printFlowReport(report *FlowReport) {
+	bold := color.New(color.Bold).SprintFunc()
+	header := color.New(color.FgCyan, color.Bold).SprintFunc()
+	faint := color.New(color.Faint).SprintFunc()
+
+	fmt.Printf("%s %s\n", bold("Flow metrics"), faint(fmt.Sprintf("(%d issues, days)", len(report.Issues))))
+
+	type row struct {
+		label string
+		stats FlowStats
+	}
+	printTable := func(title string, rows []row) {
+		labelWidth := width.StringWidth(title)
+		for _, r := range rows {
+			labelWidth = max(labelWidth, width.StringWidth(r.label))
+		}
+
+		fmt.Println()
+		fmt.Printf("%s  %s  %s  %s  %s  %s\n",
+			header(width.PadRight(title, labelWidth)),
+			header(width.PadRight("COUNT", 5)),
+			header(width.PadRight("MEAN", 6)),
+			header(width.PadRight("P50", 6)),
+			header(width.PadRight("P85", 6)),
+			header("P95"))
+		for _, r := range rows {
+			s := r.stats
+			fmt.Printf("%s  %s  %s  %s  %s  %s\n",
+				bold(width.PadRight(r.label, labelWidth)),
+				width.PadRight(strconv.Itoa(s.Count), 5),
+				width.PadRight(formatPoints(s.Mean), 6),
+				width.PadRight(formatPoints(s.P50), 6),
+				width.PadRight(formatPoints(s.P85), 6),
+				formatPoints(s.P95))
+		}
+	}
+
+	printTable("METRIC", []row{{"Lead time", report.Lead}, {"Cycle time", report.Cycle}})
+
+	var leadRows, cycleRows []row
+	for _, t := range report.ByType {
+		leadRows = append(leadRows, row{t.Type, t.Lead})
+		cycleRows = append(cycleRows, row{t.Type, t.Cycle})
+	}
+	if len(leadRows) > 0 {
+		printTable("LEAD BY TYPE", leadRows)
+		printTable("CYCLE BY TYPE", cycleRows)
+	}
+
+	var statusRows []row
+	for _, s := range report.TimeInStatus {
+		statusRows = append(statusRows, row{s.Status, s.FlowStats})
+	}
+	if len(statusRows) > 0 {
+		printTable("TIME IN STATUS", statusRows)
+	}
+}
@@ -0,0 +1,145 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+var flowCategories = map[string]string{"1": "new", "3": "indeterminate", "4": "indeterminate", "10": "done"}
+
+func TestFlowIssueFrom_LeadCycleAndTimeInStatus(t *testing.T) {
+	issue := issueValue{Key: "GCP-1", Fields: issueFields{
+		Status:    &statusField{ID: "10", Name: "Done"},
+		IssueType: &issueType{Name: "Story"},
+		Raw:       map[string]json.RawMessage{"created": json.RawMessage(`"2026-01-01T00:00:00.000+0000"`)},
+	}}
+	histories := []changelogHistory{
+		{Created: "2026-01-03T00:00:00.000+0000", Items: []changelogItem{{FieldID: "status", From: "1", FromString: "To Do", To: "3", ToString: "In Progress"}}},
+		{Created: "2026-01-04T12:00:00.000+0000", Items: []changelogItem{{FieldID: "status", From: "3", FromString: "In Progress", To: "4", ToString: "Review"}}},
+		{Created: "2026-01-05T00:00:00.000+0000", Items: []changelogItem{{FieldID: "status", From: "4", FromString: "Review", To: "10", ToString: "Done"}}},
+	}
+
+	fi := flowIssueFrom(issue, histories, flowCategories, time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC))
+
+	if fi.LeadDays == nil || *fi.LeadDays != 4 {
+		t.Errorf("expected lead time 4 days, got %v", fi.LeadDays)
+	}
+	if fi.CycleDays == nil || *fi.CycleDays != 2 {
+		t.Errorf("expected cycle time 2 days, got %v", fi.CycleDays)
+	}
+	want := map[string]float64{"To Do": 2, "In Progress": 1.5, "Review": 0.5}
+	for status, d := range want {
+		if fi.TimeInStatus[status] != d {
+			t.Errorf("expected %v days in %s, got %v", d, status, fi.TimeInStatus[status])
+		}
+	}
+	if _, ok := fi.TimeInStatus["Done"]; ok {
+		t.Error("expected no time counted in done status")
+	}
+}
+
+func TestFlowIssueFrom_ReopenedAndOpen(t *testing.T) {
+	now := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	reopened := issueValue{Key: "GCP-2", Fields: issueFields{
+		Status:    &statusField{ID: "3", Name: "In Progress"},
+		IssueType: &issueType{Name: "Bug"},
+		Raw:       map[string]json.RawMessage{"created": json.RawMessage(`"2026-01-01T00:00:00.000+0000"`)},
+	}}
+	fi := flowIssueFrom(reopened, []changelogHistory{
+		{Created: "2026-01-02T00:00:00.000+0000", Items: []changelogItem{{FieldID: "status", From: "1", FromString: "To Do", To: "10", ToString: "Done"}}},
+		{Created: "2026-01-03T00:00:00.000+0000", Items: []changelogItem{{FieldID: "status", From: "10", FromString: "Done", To: "3", ToString: "In Progress"}}},
+	}, flowCategories, now)
+	if fi.LeadDays != nil || fi.CycleDays != nil {
+		t.Errorf("expected reopened issue to have no lead or cycle time, got %v / %v", fi.LeadDays, fi.CycleDays)
+	}
+	if fi.TimeInStatus["In Progress"] != 7 {
+		t.Errorf("expected 7 days in progress until now, got %v", fi.TimeInStatus["In Progress"])
+	}
+
+	untouched := issueValue{Key: "GCP-3", Fields: issueFields{
+		Status:    &statusField{ID: "1", Name: "To Do"},
+		IssueType: &issueType{Name: "Bug"},
+		Raw:       map[string]json.RawMessage{"created": json.RawMessage(`"2026-01-08T00:00:00.000+0000"`)},
+	}}
+	fi = flowIssueFrom(untouched, nil, flowCategories, now)
+	if fi.TimeInStatus["To Do"] != 2 {
+		t.Errorf("expected 2 days in initial status, got %v", fi.TimeInStatus)
+	}
+}
+
+func TestComputeFlowReport_ByType(t *testing.T) {
+	created := map[string]json.RawMessage{"created": json.RawMessage(`"2026-01-01T00:00:00.000+0000"`)}
+	done := &statusField{ID: "10", Name: "Done"}
+	issues := []issueValue{
+		{Key: "GCP-1", Fields: issueFields{Status: done, IssueType: &issueType{Name: "Story"}, Raw: created}},
+		{Key: "GCP-2", Fields: issueFields{Status: done, IssueType: &issueType{Name: "Story"}, Raw: created}},
+		{Key: "GCP-3", Fields: issueFields{Status: done, IssueType: &issueType{Name: "Bug"}, Raw: created}},
+	}
+	toDone := []changelogItem{{FieldID: "status", From: "1", FromString: "To Do", To: "10", ToString: "Done"}}
+	histories := map[string][]changelogHistory{
+		"GCP-1": {{Created: "2026-01-02T00:00:00.000+0000", Items: toDone}},
+		"GCP-2": {{Created: "2026-01-04T00:00:00.000+0000", Items: toDone}},
+		"GCP-3": {{Created: "2026-01-11T00:00:00.000+0000", Items: toDone}},
+	}
+
+	report := computeFlowReport(issues, histories, flowCategories, time.Now())
+
+	if report.Lead.Count != 3 || report.Lead.P50 != 3 || report.Lead.Mean != 4.7 {
+		t.Errorf("unexpected lead stats: %+v", report.Lead)
+	}
+	if report.Cycle.Count != 0 {
+		t.Errorf("expected no cycle times without in-progress status, got %+v", report.Cycle)
+	}
+	if len(report.ByType) != 2 || report.ByType[0].Type != "Bug" || report.ByType[1].Lead.Count != 2 {
+		t.Errorf("unexpected by-type stats: %+v", report.ByType)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	sorted := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	tests := []struct {
+		p    float64
+		want float64
+	}{
+		{0, 1},
+		{50, 5.5},
+		{85, 8.65},
+		{100, 10},
+	}
+	for _, tt := range tests {
+		if got := percentile(sorted, tt.p); round1(got*10)/10 != round1(tt.want*10)/10 {
+			t.Errorf("percentile(%v) = %v, want %v", tt.p, got, tt.want)
+		}
+	}
+	if got := percentile(nil, 50); got != 0 {
+		t.Errorf("expected 0 for empty input, got %v", got)
+	}
+}
+
+func TestWriteFlowCSV(t *testing.T) {
+	lead := 4.0
+	report := &FlowReport{
+		TimeInStatus: []FlowStatusStats{{Status: "In Progress"}, {Status: "To Do"}},
+		Issues: []FlowIssue{{
+			Key: "GCP-1", Type: "Story", Status: "Done", Created: "2026-01-01T00:00:00Z",
+			LeadDays: &lead, TimeInStatus: map[string]float64{"To Do": 2.5},
+		}},
+	}
+
+	var buf bytes.Buffer
+	if err := writeFlowCSV(&buf, report); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if lines[0] != "key,type,status,created,started,completed,lead_days,cycle_days,days_in_In Progress,days_in_To Do" {
+		t.Errorf("unexpected header: %s", lines[0])
+	}
+	if lines[1] != "GCP-1,Story,Done,2026-01-01T00:00:00Z,,,4,,,2.5" {
+		t.Errorf("unexpected row: %s", lines[1])
+	}
+}
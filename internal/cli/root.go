@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 
 	"github.com/charmbracelet/glamour"
 	"github.com/grantcarthew/ajira/internal/api"
+	"github.com/grantcarthew/ajira/internal/config"
 	"github.com/spf13/cobra"
 	"golang.org/x/term"
 )
@@ -44,6 +46,16 @@ Environment Variables:
   JIRA_API_TOKEN       API token (overrides ATLASSIAN_API_TOKEN)
   JIRA_PROJECT         Default project key (optional)
   JIRA_BOARD           Default board ID (optional)
+  AJIRA_JOURNAL        Change journal path, or "off" (default: $XDG_STATE_HOME/ajira/journal.jsonl)
+  AJIRA_CACHE          Completion cache directory, or "off" (default: user cache directory)
+
+Policy (limits for automated agents, exit code 6 when a change is blocked):
+  AJIRA_READONLY           Block every change (true/false)
+  AJIRA_NO_DELETE          Block every delete (true/false)
+  AJIRA_ALLOW_PROJECTS     Projects that may be changed (comma-separated)
+  AJIRA_ALLOW_COMMANDS     Commands that may make changes, e.g. "issue edit,issue comment"
+  AJIRA_ALLOW_TRANSITIONS  Transitions or target statuses allowed by issue move and relocate
+  AJIRA_MAX_BATCH          Maximum issues one command may change
 
 Global Flags (work with most commands):
   --json       Output in JSON format for parsing
@@ -65,7 +77,7 @@ AI Agents: Run "ajira help agents" for a token-efficient reference.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return cmd.Help()
 	},
-	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 		// Set project from env if not specified via flag
 		if project == "" {
 			project = os.Getenv("JIRA_PROJECT")
@@ -78,6 +90,17 @@ AI Agents: Run "ajira help agents" for a token-efficient reference.`,
 		if verbose {
 			api.SetVerboseOutput(os.Stderr)
 		}
+		// Enforce the AJIRA_* policy on every change
+		policy, err := config.LoadPolicy()
+		if err != nil {
+			return err
+		}
+		api.SetPolicy(policy, commandName(cmd))
+		// Record changes for ajira log and ajira undo
+		if !dryRun {
+			enableJournal(cmd)
+		}
+		return nil
 	},
 }
 
@@ -86,12 +109,18 @@ func init() {
 	rootCmd.PersistentFlags().StringVarP(&project, "project", "p", "", "Default project key (or set JIRA_PROJECT)")
 	rootCmd.PersistentFlags().StringVar(&board, "board", "", "Default board ID for agile commands (or set JIRA_BOARD)")
 
+	_ = rootCmd.RegisterFlagCompletionFunc("project", completeProjects)
+	_ = rootCmd.RegisterFlagCompletionFunc("board", completeBoards)
+
 	// Automation flags
 	rootCmd.PersistentFlags().BoolVar(&dryRun, "dry-run", false, "Show planned actions without executing")
 	rootCmd.PersistentFlags().BoolVar(&verbose, "verbose", false, "Show HTTP request/response details")
 	rootCmd.PersistentFlags().BoolVar(&quiet, "quiet", false, "Suppress non-essential output")
 	rootCmd.PersistentFlags().BoolVar(&noColor, "no-color", false, "Disable coloured output")
 
+	// Errors are printed by Execute, as JSON with --json
+	rootCmd.SilenceErrors = true
+
 	// Disable Cobra's verbose completion command, we'll add our own
 	rootCmd.CompletionOptions.DisableDefaultCmd = true
 
@@ -130,7 +159,17 @@ Use "{{.CommandPath}} <command> --help" for more information about a command.{{e
 func Execute() error {
 	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer cancel()
-	return rootCmd.ExecuteContext(ctx)
+	err := rootCmd.ExecuteContext(ctx)
+	if err != nil {
+		PrintError(os.Stderr, err)
+	}
+	return err
+}
+
+// commandName returns the command path without the program name, such as
+// "issue edit".
+func commandName(cmd *cobra.Command) string {
+	return strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()+" ")
 }
 
 // JSONOutput returns true if JSON output is requested.
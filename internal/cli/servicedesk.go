@@ -0,0 +1,124 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/grantcarthew/ajira/internal/api"
+	"github.com/spf13/cobra"
+)
+
+// ServiceDeskInfo represents a Jira Service Management service desk.
+type ServiceDeskInfo struct {
+	ID          string `json:"id"`
+	ProjectID   string `json:"projectId"`
+	ProjectKey  string `json:"projectKey"`
+	ProjectName string `json:"projectName"`
+}
+
+// serviceDeskPage matches the paged response format of the Service
+// Management API.
+type serviceDeskPage struct {
+	Size       int               `json:"size"`
+	Start      int               `json:"start"`
+	Limit      int               `json:"limit"`
+	IsLastPage bool              `json:"isLastPage"`
+	Values     []json.RawMessage `json:"values"`
+}
+
+var servicedeskCmd = &cobra.Command{
+	Use:     "servicedesk",
+	Aliases: []string{"sd"},
+	Short:   "Jira Service Management",
+	Long: `Commands for Jira Service Management: service desks, request types, customer requests, queues, and SLAs.
+The service desk is taken from -p or JIRA_PROJECT, as a project key or service desk ID.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(servicedeskCmd)
+}
+
+// fetchServiceDeskValues pages through a Service Management list endpoint
+// and returns the raw values. A limit of 0 returns all values.
+func fetchServiceDeskValues(ctx context.Context, client *api.Client, path string, limit int) ([]json.RawMessage, error) {
+	var values []json.RawMessage
+	start := 0
+	const maxPages = 100
+
+	sep := "?"
+	if strings.Contains(path, "?") {
+		sep = "&"
+	}
+
+	for range maxPages {
+		body, err := client.ServiceDeskGet(ctx, fmt.Sprintf("%s%sstart=%d&limit=50", path, sep, start))
+		if err != nil {
+			return nil, err
+		}
+
+		var page serviceDeskPage
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, fmt.Errorf("failed to parse response: %w", err)
+		}
+
+		for _, v := range page.Values {
+			values = append(values, v)
+			if limit > 0 && len(values) >= limit {
+				return values, nil
+			}
+		}
+
+		start += len(page.Values)
+		if page.IsLastPage || len(page.Values) == 0 {
+			break
+		}
+	}
+
+	return values, nil
+}
+
+// fetchServiceDesks lists the service desks the user can access.
+func fetchServiceDesks(ctx context.Context, client *api.Client) ([]ServiceDeskInfo, error) {
+	values, err := fetchServiceDeskValues(ctx, client, "/servicedesk", 0)
+	if err != nil {
+		return nil, err
+	}
+
+	desks := make([]ServiceDeskInfo, 0, len(values))
+	for _, v := range values {
+		var desk ServiceDeskInfo
+		if err := json.Unmarshal(v, &desk); err != nil {
+			return nil, fmt.Errorf("failed to parse response: %w", err)
+		}
+		desks = append(desks, desk)
+	}
+	return desks, nil
+}
+
+// resolveServiceDeskID returns the service desk ID for a numeric ID or a
+// project key.
+func resolveServiceDeskID(ctx context.Context, client *api.Client, projectOrID string) (string, error) {
+	if projectOrID == "" {
+		return "", fmt.Errorf("service desk required: use -p flag or set JIRA_PROJECT environment variable")
+	}
+	if _, err := strconv.Atoi(projectOrID); err == nil {
+		return projectOrID, nil
+	}
+
+	desks, err := fetchServiceDesks(ctx, client)
+	if err != nil {
+		return "", err
+	}
+	for _, d := range desks {
+		if strings.EqualFold(d.ProjectKey, projectOrID) {
+			return d.ID, nil
+		}
+	}
+	return "", fmt.Errorf("no service desk found for project %s", projectOrID)
+}
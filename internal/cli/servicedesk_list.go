@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/grantcarthew/ajira/internal/api"
+	"github.com/grantcarthew/ajira/internal/config"
+	"github.com/spf13/cobra"
+)
+
+var servicedeskListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List service desks",
+	Long:    "List the service desks you can access, with their IDs and project keys.",
+	Example: `  ajira servicedesk list
+  ajira sd list --json`,
+	SilenceUsage: true,
+	RunE:         runServicedeskList,
+}
+
+func init() {
+	servicedeskCmd.AddCommand(servicedeskListCmd)
+}
+
+func runServicedeskList(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(cfg)
+
+	desks, err := fetchServiceDesks(ctx, client)
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); ok {
+			return fmt.Errorf("API error: %w", apiErr)
+		}
+		return fmt.Errorf("failed to fetch service desks: %w", err)
+	}
+
+	if JSONOutput() {
+		output, err := json.MarshalIndent(desks, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to format JSON: %w", err)
+		}
+		fmt.Println(string(output))
+		return nil
+	}
+
+	if len(desks) == 0 {
+		fmt.Println("No service desks found.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tPROJECT\tNAME")
+	for _, d := range desks {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", d.ID, d.ProjectKey, d.ProjectName)
+	}
+	w.Flush()
+
+	return nil
+}
@@ -0,0 +1,201 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/grantcarthew/ajira/internal/api"
+	"github.com/grantcarthew/ajira/internal/config"
+	"github.com/spf13/cobra"
+)
+
+// QueueInfo represents a service desk queue.
+type QueueInfo struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	JQL        string `json:"jql"`
+	IssueCount int    `json:"issueCount"`
+}
+
+var servicedeskQueueIssuesLimit int
+
+var servicedeskQueueCmd = &cobra.Command{
+	Use:     "queue",
+	Aliases: []string{"queues"},
+	Short:   "Manage queues",
+	Long:    "Commands for listing service desk queues and their issues.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+}
+
+var servicedeskQueueListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List queues",
+	Long:    "List the queues of a service desk with their issue counts.",
+	Example: `  ajira servicedesk queue list -p SUP
+  ajira sd queue list --json`,
+	SilenceUsage: true,
+	RunE:         runServicedeskQueueList,
+}
+
+var servicedeskQueueIssuesCmd = &cobra.Command{
+	Use:   "issues <queue>",
+	Short: "List queue issues",
+	Long:  "List the issues in a queue, by queue name or ID, in queue order.",
+	Example: `  ajira servicedesk queue issues "Unassigned issues" -p SUP
+  ajira sd queue issues 7 -l 100 --json`,
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE:         runServicedeskQueueIssues,
+}
+
+func init() {
+	servicedeskQueueIssuesCmd.Flags().IntVarP(&servicedeskQueueIssuesLimit, "limit", "l", 50, "Maximum issues to return")
+
+	servicedeskQueueCmd.AddCommand(servicedeskQueueListCmd)
+	servicedeskQueueCmd.AddCommand(servicedeskQueueIssuesCmd)
+	servicedeskCmd.AddCommand(servicedeskQueueCmd)
+}
+
+func runServicedeskQueueList(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(cfg)
+
+	deskID, err := resolveServiceDeskID(ctx, client, Project())
+	if err != nil {
+		return err
+	}
+
+	queues, err := fetchQueues(ctx, client, deskID)
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); ok {
+			return fmt.Errorf("API error: %w", apiErr)
+		}
+		return fmt.Errorf("failed to fetch queues: %w", err)
+	}
+
+	if JSONOutput() {
+		output, err := json.MarshalIndent(queues, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to format JSON: %w", err)
+		}
+		fmt.Println(string(output))
+		return nil
+	}
+
+	if len(queues) == 0 {
+		fmt.Println("No queues found.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tISSUES")
+	for _, q := range queues {
+		fmt.Fprintf(w, "%s\t%s\t%d\n", q.ID, q.Name, q.IssueCount)
+	}
+	w.Flush()
+
+	return nil
+}
+
+func runServicedeskQueueIssues(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(cfg)
+
+	deskID, err := resolveServiceDeskID(ctx, client, Project())
+	if err != nil {
+		return err
+	}
+
+	issues, err := getQueueIssues(ctx, client, deskID, args[0], servicedeskQueueIssuesLimit)
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); ok {
+			return fmt.Errorf("API error: %w", apiErr)
+		}
+		return fmt.Errorf("failed to fetch queue issues: %w", err)
+	}
+
+	if JSONOutput() {
+		output, err := json.MarshalIndent(issues, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to format JSON: %w", err)
+		}
+		fmt.Println(string(output))
+	} else {
+		printIssueList(issues)
+	}
+
+	return nil
+}
+
+func fetchQueues(ctx context.Context, client *api.Client, deskID string) ([]QueueInfo, error) {
+	values, err := fetchServiceDeskValues(ctx, client, fmt.Sprintf("/servicedesk/%s/queue?includeCount=true", deskID), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	queues := make([]QueueInfo, 0, len(values))
+	for _, v := range values {
+		var q QueueInfo
+		if err := json.Unmarshal(v, &q); err != nil {
+			return nil, fmt.Errorf("failed to parse response: %w", err)
+		}
+		queues = append(queues, q)
+	}
+	return queues, nil
+}
+
+// getQueueIssues lists the issues of a queue given by name or ID.
+func getQueueIssues(ctx context.Context, client *api.Client, deskID, nameOrID string, limit int) ([]IssueInfo, error) {
+	queueID := nameOrID
+	if _, err := strconv.Atoi(nameOrID); err != nil {
+		queues, err := fetchQueues(ctx, client, deskID)
+		if err != nil {
+			return nil, err
+		}
+		queueID = ""
+		for _, q := range queues {
+			if strings.EqualFold(q.Name, nameOrID) {
+				queueID = q.ID
+				break
+			}
+		}
+		if queueID == "" {
+			return nil, fmt.Errorf("queue not found: %s", nameOrID)
+		}
+	}
+
+	values, err := fetchServiceDeskValues(ctx, client, fmt.Sprintf("/servicedesk/%s/queue/%s/issue", deskID, queueID), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	issues := make([]IssueInfo, 0, len(values))
+	for _, raw := range values {
+		var v issueValue
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("failed to parse response: %w", err)
+		}
+		issues = append(issues, issueInfoFromValue(v))
+	}
+	return issues, nil
+}
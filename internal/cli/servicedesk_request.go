@@ -0,0 +1,393 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/grantcarthew/ajira/internal/api"
+	"github.com/grantcarthew/ajira/internal/config"
+	"github.com/spf13/cobra"
+)
+
+// RequestResult represents a created customer request.
+type RequestResult struct {
+	Key         string `json:"key"`
+	ID          string `json:"id"`
+	RequestType string `json:"requestType"`
+	PortalURL   string `json:"portalUrl,omitempty"`
+}
+
+// RequestCommentResult represents a comment added to a customer request.
+type RequestCommentResult struct {
+	ID      string `json:"id"`
+	Public  bool   `json:"public"`
+	Created string `json:"created"`
+}
+
+// requestCreateRequest is the request body for creating a customer request.
+type requestCreateRequest struct {
+	ServiceDeskID      string         `json:"serviceDeskId"`
+	RequestTypeID      string         `json:"requestTypeId"`
+	RequestFieldValues map[string]any `json:"requestFieldValues"`
+	RaiseOnBehalfOf    string         `json:"raiseOnBehalfOf,omitempty"`
+}
+
+// requestCreateResponse matches the create customer request API response.
+type requestCreateResponse struct {
+	IssueID  string `json:"issueId"`
+	IssueKey string `json:"issueKey"`
+	Links    struct {
+		Web string `json:"web"`
+	} `json:"_links"`
+}
+
+// requestCommentResponse matches the request comment API response.
+type requestCommentResponse struct {
+	ID      string `json:"id"`
+	Public  bool   `json:"public"`
+	Created struct {
+		ISO8601 string `json:"iso8601"`
+	} `json:"created"`
+}
+
+var (
+	requestCreateType        string
+	requestCreateSummary     string
+	requestCreateDescription string
+	requestCreateFile        string
+	requestCreateFields      []string
+	requestCreateOnBehalfOf  string
+
+	requestCommentBody     string
+	requestCommentFile     string
+	requestCommentInternal bool
+)
+
+var servicedeskRequestCmd = &cobra.Command{
+	Use:     "request",
+	Aliases: []string{"requests"},
+	Short:   "Manage customer requests",
+	Long:    "Commands for creating customer requests, commenting on them, and viewing their SLAs.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+}
+
+var servicedeskRequestCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create customer request",
+	Long: `Create a customer request of a given request type. Set request type fields with --field name=value,
+where name is the field name or ID. Select fields accept an option label or ID; multi-value fields
+take comma-separated values. Use 'servicedesk request-type fields' to see available fields.`,
+	Example: `  ajira servicedesk request create -p SUP -t "Get IT help" -s "Laptop will not boot"
+  ajira sd request create -t "Request access" -s "VPN access" --field "Urgency=High"
+  ajira sd request create -t 12 -s "Printer jam" -f details.md --on-behalf-of user@example.com`,
+	SilenceUsage: true,
+	RunE:         runServicedeskRequestCreate,
+}
+
+var servicedeskRequestCommentCmd = &cobra.Command{
+	Use:   "comment <issue-key> [text]",
+	Short: "Comment on customer request",
+	Long:  "Add a comment to a customer request. Comments are visible to the customer unless --internal is given.",
+	Example: `  ajira servicedesk request comment SUP-12 "We are looking into it"
+  ajira sd request comment SUP-12 "Escalated to network team" --internal
+  ajira sd request comment SUP-12 -f reply.md`,
+	Args:         cobra.RangeArgs(1, 2),
+	SilenceUsage: true,
+	RunE:         runServicedeskRequestComment,
+}
+
+func init() {
+	servicedeskRequestCreateCmd.Flags().StringVarP(&requestCreateType, "type", "t", "", "Request type name or ID (required)")
+	servicedeskRequestCreateCmd.Flags().StringVarP(&requestCreateSummary, "summary", "s", "", "Request summary (required)")
+	servicedeskRequestCreateCmd.Flags().StringVarP(&requestCreateDescription, "description", "d", "", "Request description")
+	servicedeskRequestCreateCmd.Flags().StringVarP(&requestCreateFile, "file", "f", "", "Read description from file (use - for stdin)")
+	servicedeskRequestCreateCmd.Flags().StringArrayVar(&requestCreateFields, "field", nil, "Request type field as name=value (repeatable)")
+	servicedeskRequestCreateCmd.Flags().StringVar(&requestCreateOnBehalfOf, "on-behalf-of", "", "Raise on behalf of a customer (email or account ID)")
+	_ = servicedeskRequestCreateCmd.MarkFlagRequired("type")
+	_ = servicedeskRequestCreateCmd.MarkFlagRequired("summary")
+
+	servicedeskRequestCommentCmd.Flags().StringVarP(&requestCommentBody, "body", "b", "", "Comment text")
+	servicedeskRequestCommentCmd.Flags().StringVarP(&requestCommentFile, "file", "f", "", "Read comment from file (use - for stdin)")
+	servicedeskRequestCommentCmd.Flags().BoolVar(&requestCommentInternal, "internal", false, "Internal comment, visible to agents only")
+
+	servicedeskRequestCmd.AddCommand(servicedeskRequestCreateCmd)
+	servicedeskRequestCmd.AddCommand(servicedeskRequestCommentCmd)
+	servicedeskCmd.AddCommand(servicedeskRequestCmd)
+}
+
+func runServicedeskRequestCreate(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(cfg)
+
+	description, err := readText(requestCreateFile, requestCreateDescription)
+	if err != nil {
+		return fmt.Errorf("failed to read description: %w", err)
+	}
+
+	deskID, err := resolveServiceDeskID(ctx, client, Project())
+	if err != nil {
+		return err
+	}
+
+	requestType, err := resolveRequestType(ctx, client, deskID, requestCreateType)
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); ok {
+			return fmt.Errorf("API error: %w", apiErr)
+		}
+		return err
+	}
+
+	fields, err := fetchRequestTypeFields(ctx, client, deskID, requestType.ID)
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); ok {
+			return fmt.Errorf("API error: %w", apiErr)
+		}
+		return fmt.Errorf("failed to fetch request type fields: %w", err)
+	}
+
+	values, err := buildRequestFieldValues(fields, requestCreateSummary, description, requestCreateFields)
+	if err != nil {
+		return err
+	}
+
+	req := requestCreateRequest{
+		ServiceDeskID:      deskID,
+		RequestTypeID:      requestType.ID,
+		RequestFieldValues: values,
+	}
+	if requestCreateOnBehalfOf != "" {
+		accountID, err := resolveUser(ctx, client, requestCreateOnBehalfOf)
+		if err != nil {
+			return fmt.Errorf("failed to resolve customer: %w", err)
+		}
+		if accountID == "" {
+			return fmt.Errorf("user not found: %s", requestCreateOnBehalfOf)
+		}
+		req.RaiseOnBehalfOf = accountID
+	}
+
+	if DryRun() {
+		PrintDryRun(fmt.Sprintf("create %q request: %s", requestType.Name, requestCreateSummary))
+		return nil
+	}
+
+	result, err := createRequest(ctx, client, req)
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); ok {
+			return fmt.Errorf("API error: %w", apiErr)
+		}
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	result.RequestType = requestType.Name
+
+	if JSONOutput() {
+		PrintSuccessJSON(result)
+	} else {
+		PrintSuccess(IssueURL(cfg.BaseURL, result.Key))
+	}
+	return nil
+}
+
+// buildRequestFieldValues maps the summary, description, and --field
+// name=value pairs to request field values, and checks that every required
+// field of the request type is set.
+func buildRequestFieldValues(fields []RequestTypeField, summary, description string, pairs []string) (map[string]any, error) {
+	values := map[string]any{"summary": summary}
+	if description != "" {
+		values["description"] = description
+	}
+
+	for _, pair := range pairs {
+		name, raw, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid --field: %s (use name=value)", pair)
+		}
+		name = strings.TrimSpace(name)
+
+		var field *RequestTypeField
+		for i := range fields {
+			if fields[i].FieldID == name || strings.EqualFold(fields[i].Name, name) {
+				field = &fields[i]
+				break
+			}
+		}
+		if field == nil {
+			return nil, fmt.Errorf("field not found on request type: %s", name)
+		}
+
+		value, err := requestFieldValue(*field, strings.TrimSpace(raw))
+		if err != nil {
+			return nil, err
+		}
+		values[field.FieldID] = value
+	}
+
+	var missing []string
+	for _, f := range fields {
+		if _, ok := values[f.FieldID]; f.Required && !ok {
+			missing = append(missing, f.Name)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("missing required fields: %s (set with --field)", strings.Join(missing, ", "))
+	}
+
+	return values, nil
+}
+
+// requestFieldValue converts a --field value to the form the request type
+// field expects: option IDs for selects, numbers, or plain strings.
+func requestFieldValue(field RequestTypeField, raw string) (any, error) {
+	multi := field.Type == "array"
+	parts := []string{raw}
+	if multi {
+		parts = nil
+		for _, p := range strings.Split(raw, ",") {
+			if p = strings.TrimSpace(p); p != "" {
+				parts = append(parts, p)
+			}
+		}
+	}
+
+	if len(field.ValidValues) > 0 {
+		options := make([]map[string]string, 0, len(parts))
+		for _, p := range parts {
+			id := ""
+			for _, v := range field.ValidValues {
+				if v.Value == p || strings.EqualFold(v.Label, p) {
+					id = v.Value
+					break
+				}
+			}
+			if id == "" {
+				labels := make([]string, len(field.ValidValues))
+				for i, v := range field.ValidValues {
+					labels[i] = v.Label
+				}
+				return nil, fmt.Errorf("invalid value for %s: %s (valid: %s)", field.Name, p, strings.Join(labels, ", "))
+			}
+			options = append(options, map[string]string{"id": id})
+		}
+		if multi {
+			return options, nil
+		}
+		return options[0], nil
+	}
+
+	switch {
+	case multi:
+		return parts, nil
+	case field.Type == "number":
+		n, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number for %s: %s", field.Name, raw)
+		}
+		return n, nil
+	default:
+		return raw, nil
+	}
+}
+
+func createRequest(ctx context.Context, client *api.Client, req requestCreateRequest) (*RequestResult, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	respBody, err := client.ServiceDeskPost(ctx, "/request", body)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp requestCreateResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &RequestResult{Key: resp.IssueKey, ID: resp.IssueID, PortalURL: resp.Links.Web}, nil
+}
+
+func runServicedeskRequestComment(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	issueKey := args[0]
+
+	text := ""
+	if len(args) > 1 {
+		text = args[1]
+	}
+	if requestCommentFile != "" || requestCommentBody != "" {
+		var err error
+		text, err = readText(requestCommentFile, requestCommentBody)
+		if err != nil {
+			return fmt.Errorf("failed to read comment: %w", err)
+		}
+	}
+	if text == "" {
+		return fmt.Errorf("comment text is required (provide as argument, --body, or --file)")
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	if DryRun() {
+		kind := "public"
+		if requestCommentInternal {
+			kind = "internal"
+		}
+		preview := text
+		if len(preview) > 50 {
+			preview = preview[:50] + "..."
+		}
+		PrintDryRun(fmt.Sprintf("add %s comment to %s: %q", kind, issueKey, preview))
+		return nil
+	}
+
+	client := api.NewClient(cfg)
+
+	result, err := addRequestComment(ctx, client, issueKey, text, !requestCommentInternal)
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); ok {
+			return fmt.Errorf("API error: %w", apiErr)
+		}
+		return fmt.Errorf("failed to add comment: %w", err)
+	}
+
+	if JSONOutput() {
+		PrintSuccessJSON(result)
+	} else {
+		PrintSuccess(IssueURL(cfg.BaseURL, issueKey))
+	}
+	return nil
+}
+
+func addRequestComment(ctx context.Context, client *api.Client, issueKey, text string, public bool) (*RequestCommentResult, error) {
+	body, err := json.Marshal(map[string]any{"body": text, "public": public})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	respBody, err := client.ServiceDeskPost(ctx, fmt.Sprintf("/request/%s/comment", issueKey), body)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp requestCommentResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	return &RequestCommentResult{ID: resp.ID, Public: resp.Public, Created: resp.Created.ISO8601}, nil
+}
@@ -0,0 +1,252 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/grantcarthew/ajira/internal/api"
+	"github.com/grantcarthew/ajira/internal/config"
+	"github.com/spf13/cobra"
+)
+
+// RequestTypeInfo represents a service desk request type.
+type RequestTypeInfo struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	IssueTypeID string `json:"issueTypeId"`
+}
+
+// RequestTypeField is a field customers fill in for a request type.
+// ValidValues is set for select fields.
+type RequestTypeField struct {
+	FieldID     string             `json:"fieldId"`
+	Name        string             `json:"name"`
+	Required    bool               `json:"required"`
+	Type        string             `json:"type"`
+	ValidValues []RequestTypeValue `json:"validValues,omitempty"`
+}
+
+// RequestTypeValue is an allowed value of a request type field.
+type RequestTypeValue struct {
+	Value string `json:"value"`
+	Label string `json:"label"`
+}
+
+// requestTypeFieldsResponse matches the request type fields API response.
+type requestTypeFieldsResponse struct {
+	RequestTypeFields []struct {
+		FieldID     string             `json:"fieldId"`
+		Name        string             `json:"name"`
+		Required    bool               `json:"required"`
+		ValidValues []RequestTypeValue `json:"validValues"`
+		JiraSchema  struct {
+			Type string `json:"type"`
+		} `json:"jiraSchema"`
+	} `json:"requestTypeFields"`
+}
+
+var servicedeskRequestTypeCmd = &cobra.Command{
+	Use:     "request-type",
+	Aliases: []string{"request-types"},
+	Short:   "Manage request types",
+	Long:    "Commands for listing service desk request types and their fields.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+}
+
+var servicedeskRequestTypeListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List request types",
+	Long:    "List the request types of a service desk.",
+	Example: `  ajira servicedesk request-type list -p SUP
+  ajira sd request-type list --json`,
+	SilenceUsage: true,
+	RunE:         runServicedeskRequestTypeList,
+}
+
+var servicedeskRequestTypeFieldsCmd = &cobra.Command{
+	Use:   "fields <request-type>",
+	Short: "List request type fields",
+	Long:  "List the fields of a request type, by name or ID, with required flags and allowed values.",
+	Example: `  ajira servicedesk request-type fields "Get IT help" -p SUP
+  ajira sd request-type fields 12 --json`,
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE:         runServicedeskRequestTypeFields,
+}
+
+func init() {
+	servicedeskRequestTypeCmd.AddCommand(servicedeskRequestTypeListCmd)
+	servicedeskRequestTypeCmd.AddCommand(servicedeskRequestTypeFieldsCmd)
+	servicedeskCmd.AddCommand(servicedeskRequestTypeCmd)
+}
+
+func runServicedeskRequestTypeList(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(cfg)
+
+	deskID, err := resolveServiceDeskID(ctx, client, Project())
+	if err != nil {
+		return err
+	}
+
+	types, err := fetchRequestTypes(ctx, client, deskID)
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); ok {
+			return fmt.Errorf("API error: %w", apiErr)
+		}
+		return fmt.Errorf("failed to fetch request types: %w", err)
+	}
+
+	if JSONOutput() {
+		output, err := json.MarshalIndent(types, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to format JSON: %w", err)
+		}
+		fmt.Println(string(output))
+		return nil
+	}
+
+	if len(types) == 0 {
+		fmt.Println("No request types found.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tDESCRIPTION")
+	for _, t := range types {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", t.ID, t.Name, t.Description)
+	}
+	w.Flush()
+
+	return nil
+}
+
+func runServicedeskRequestTypeFields(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(cfg)
+
+	deskID, err := resolveServiceDeskID(ctx, client, Project())
+	if err != nil {
+		return err
+	}
+
+	requestType, err := resolveRequestType(ctx, client, deskID, args[0])
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); ok {
+			return fmt.Errorf("API error: %w", apiErr)
+		}
+		return err
+	}
+
+	fields, err := fetchRequestTypeFields(ctx, client, deskID, requestType.ID)
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); ok {
+			return fmt.Errorf("API error: %w", apiErr)
+		}
+		return fmt.Errorf("failed to fetch request type fields: %w", err)
+	}
+
+	if JSONOutput() {
+		output, err := json.MarshalIndent(fields, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to format JSON: %w", err)
+		}
+		fmt.Println(string(output))
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "FIELD\tNAME\tTYPE\tREQUIRED\tVALUES")
+	for _, f := range fields {
+		required := ""
+		if f.Required {
+			required = "Yes"
+		}
+		labels := make([]string, len(f.ValidValues))
+		for i, v := range f.ValidValues {
+			labels[i] = v.Label
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", f.FieldID, f.Name, f.Type, required, strings.Join(labels, ", "))
+	}
+	w.Flush()
+
+	return nil
+}
+
+func fetchRequestTypes(ctx context.Context, client *api.Client, deskID string) ([]RequestTypeInfo, error) {
+	values, err := fetchServiceDeskValues(ctx, client, fmt.Sprintf("/servicedesk/%s/requesttype", deskID), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	types := make([]RequestTypeInfo, 0, len(values))
+	for _, v := range values {
+		var t RequestTypeInfo
+		if err := json.Unmarshal(v, &t); err != nil {
+			return nil, fmt.Errorf("failed to parse response: %w", err)
+		}
+		types = append(types, t)
+	}
+	return types, nil
+}
+
+// resolveRequestType finds a request type by ID or case-insensitive name.
+func resolveRequestType(ctx context.Context, client *api.Client, deskID, nameOrID string) (*RequestTypeInfo, error) {
+	types, err := fetchRequestTypes(ctx, client, deskID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, numErr := strconv.Atoi(nameOrID)
+	for _, t := range types {
+		if (numErr == nil && t.ID == nameOrID) || strings.EqualFold(t.Name, nameOrID) {
+			return &t, nil
+		}
+	}
+	return nil, fmt.Errorf("request type not found: %s", nameOrID)
+}
+
+func fetchRequestTypeFields(ctx context.Context, client *api.Client, deskID, requestTypeID string) ([]RequestTypeField, error) {
+	body, err := client.ServiceDeskGet(ctx, fmt.Sprintf("/servicedesk/%s/requesttype/%s/field", deskID, requestTypeID))
+	if err != nil {
+		return nil, err
+	}
+
+	var resp requestTypeFieldsResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	fields := make([]RequestTypeField, 0, len(resp.RequestTypeFields))
+	for _, f := range resp.RequestTypeFields {
+		fields = append(fields, RequestTypeField{
+			FieldID:     f.FieldID,
+			Name:        f.Name,
+			Required:    f.Required,
+			Type:        f.JiraSchema.Type,
+			ValidValues: f.ValidValues,
+		})
+	}
+	return fields, nil
+}
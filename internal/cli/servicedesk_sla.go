@@ -0,0 +1,201 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/grantcarthew/ajira/internal/api"
+	"github.com/grantcarthew/ajira/internal/config"
+	"github.com/spf13/cobra"
+)
+
+// SLAInfo is an SLA metric of a customer request with its ongoing and
+// completed cycles.
+type SLAInfo struct {
+	ID        string     `json:"id"`
+	Name      string     `json:"name"`
+	Ongoing   *SLACycle  `json:"ongoing,omitempty"`
+	Completed []SLACycle `json:"completed"`
+}
+
+// SLACycle is one SLA cycle. Durations use Jira's friendly format (e.g.
+// "2h 30m"); Remaining is negative once breached.
+type SLACycle struct {
+	StartTime           string `json:"startTime"`
+	StopTime            string `json:"stopTime,omitempty"`
+	BreachTime          string `json:"breachTime,omitempty"`
+	Breached            bool   `json:"breached"`
+	Paused              bool   `json:"paused,omitempty"`
+	WithinCalendarHours bool   `json:"withinCalendarHours"`
+	Goal                string `json:"goal"`
+	Elapsed             string `json:"elapsed"`
+	Remaining           string `json:"remaining"`
+	RemainingMillis     int64  `json:"remainingMillis"`
+}
+
+// slaValue matches an entry of the request SLA API response.
+type slaValue struct {
+	ID              string          `json:"id"`
+	Name            string          `json:"name"`
+	OngoingCycle    *slaCycleValue  `json:"ongoingCycle"`
+	CompletedCycles []slaCycleValue `json:"completedCycles"`
+}
+
+type slaCycleValue struct {
+	StartTime           slaDate     `json:"startTime"`
+	StopTime            slaDate     `json:"stopTime"`
+	BreachTime          slaDate     `json:"breachTime"`
+	Breached            bool        `json:"breached"`
+	Paused              bool        `json:"paused"`
+	WithinCalendarHours bool        `json:"withinCalendarHours"`
+	GoalDuration        slaDuration `json:"goalDuration"`
+	ElapsedTime         slaDuration `json:"elapsedTime"`
+	RemainingTime       slaDuration `json:"remainingTime"`
+}
+
+type slaDate struct {
+	ISO8601 string `json:"iso8601"`
+}
+
+type slaDuration struct {
+	Millis   int64  `json:"millis"`
+	Friendly string `json:"friendly"`
+}
+
+var servicedeskRequestSLACmd = &cobra.Command{
+	Use:   "sla <issue-key>",
+	Short: "Show request SLAs",
+	Long:  "Show the SLA metrics of a customer request: ongoing cycle with remaining time and breach time, and completed cycles.",
+	Example: `  ajira servicedesk request sla SUP-12
+  ajira sd request sla SUP-12 --json`,
+	Args:         cobra.ExactArgs(1),
+	SilenceUsage: true,
+	RunE:         runServicedeskRequestSLA,
+}
+
+func init() {
+	servicedeskRequestCmd.AddCommand(servicedeskRequestSLACmd)
+}
+
+func runServicedeskRequestSLA(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(cfg)
+
+	slas, err := fetchRequestSLAs(ctx, client, args[0])
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); ok {
+			return fmt.Errorf("API error: %w", apiErr)
+		}
+		return fmt.Errorf("failed to fetch SLAs: %w", err)
+	}
+
+	if JSONOutput() {
+		output, err := json.MarshalIndent(slas, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to format JSON: %w", err)
+		}
+		fmt.Println(string(output))
+		return nil
+	}
+
+	printRequestSLAs(args[0], slas)
+	return nil
+}
+
+func fetchRequestSLAs(ctx context.Context, client *api.Client, issueKey string) ([]SLAInfo, error) {
+	values, err := fetchServiceDeskValues(ctx, client, fmt.Sprintf("/request/%s/sla", issueKey), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	slas := make([]SLAInfo, 0, len(values))
+	for _, raw := range values {
+		var v slaValue
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, fmt.Errorf("failed to parse response: %w", err)
+		}
+
+		info := SLAInfo{ID: v.ID, Name: v.Name, Completed: []SLACycle{}}
+		if v.OngoingCycle != nil {
+			cycle := slaCycleFromValue(*v.OngoingCycle)
+			info.Ongoing = &cycle
+		}
+		for _, c := range v.CompletedCycles {
+			info.Completed = append(info.Completed, slaCycleFromValue(c))
+		}
+		slas = append(slas, info)
+	}
+	return slas, nil
+}
+
+func slaCycleFromValue(v slaCycleValue) SLACycle {
+	return SLACycle{
+		StartTime:           v.StartTime.ISO8601,
+		StopTime:            v.StopTime.ISO8601,
+		BreachTime:          v.BreachTime.ISO8601,
+		Breached:            v.Breached,
+		Paused:              v.Paused,
+		WithinCalendarHours: v.WithinCalendarHours,
+		Goal:                v.GoalDuration.Friendly,
+		Elapsed:             v.ElapsedTime.Friendly,
+		Remaining:           v.RemainingTime.Friendly,
+		RemainingMillis:     v.RemainingTime.Millis,
+	}
+}
+
+// slaState summarises a cycle as met, breached, paused, or running.
+func slaState(c SLACycle, ongoing bool) string {
+	switch {
+	case c.Breached:
+		return "breached"
+	case !ongoing:
+		return "met"
+	case c.Paused:
+		return "paused"
+	default:
+		return "running"
+	}
+}
+
+func printRequestSLAs(issueKey string, slas []SLAInfo) {
+	if len(slas) == 0 {
+		fmt.Printf("No SLAs for %s\n", issueKey)
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "SLA\tSTATE\tREMAINING\tGOAL\tBREACH TIME")
+	for _, s := range slas {
+		var cycle SLACycle
+		ongoing := s.Ongoing != nil
+		switch {
+		case ongoing:
+			cycle = *s.Ongoing
+		case len(s.Completed) > 0:
+			cycle = s.Completed[len(s.Completed)-1]
+		default:
+			fmt.Fprintf(w, "%s\t-\t-\t-\t-\n", s.Name)
+			continue
+		}
+
+		breach := "-"
+		if cycle.BreachTime != "" {
+			breach = formatDateTime(cycle.BreachTime)
+		}
+		remaining := cycle.Remaining
+		if remaining == "" {
+			remaining = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", s.Name, slaState(cycle, ongoing), remaining, cycle.Goal, breach)
+	}
+	w.Flush()
+}
@@ -0,0 +1,240 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/grantcarthew/ajira/internal/api"
+)
+
+func TestResolveServiceDeskID(t *testing.T) {
+	pages := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/servicedeskapi/servicedesk" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		pages++
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("start") == "0" {
+			_, _ = w.Write([]byte(`{"start": 0, "size": 1, "isLastPage": false, "values": [{"id": "1", "projectKey": "IT"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"start": 1, "size": 1, "isLastPage": true, "values": [{"id": "4", "projectKey": "SUP"}]}`))
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+
+	id, err := resolveServiceDeskID(context.Background(), client, "sup")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if id != "4" {
+		t.Errorf("expected service desk 4, got %s", id)
+	}
+	if pages != 2 {
+		t.Errorf("expected 2 page requests, got %d", pages)
+	}
+
+	id, err = resolveServiceDeskID(context.Background(), client, "9")
+	if err != nil || id != "9" {
+		t.Errorf("expected numeric ID passed through, got %q, %v", id, err)
+	}
+
+	if _, err := resolveServiceDeskID(context.Background(), client, "NOPE"); err == nil {
+		t.Error("expected error for unknown project")
+	}
+	if _, err := resolveServiceDeskID(context.Background(), client, ""); err == nil {
+		t.Error("expected error without project")
+	}
+}
+
+func TestBuildRequestFieldValues(t *testing.T) {
+	fields := []RequestTypeField{
+		{FieldID: "summary", Name: "Summary", Required: true, Type: "string"},
+		{FieldID: "description", Name: "Description", Type: "string"},
+		{FieldID: "customfield_10010", Name: "Urgency", Required: true, Type: "option",
+			ValidValues: []RequestTypeValue{{Value: "1", Label: "High"}, {Value: "2", Label: "Low"}}},
+		{FieldID: "customfield_10020", Name: "Systems", Type: "array",
+			ValidValues: []RequestTypeValue{{Value: "10", Label: "VPN"}, {Value: "11", Label: "Email"}}},
+		{FieldID: "customfield_10030", Name: "Seats", Type: "number"},
+		{FieldID: "labels", Name: "Labels", Type: "array"},
+	}
+
+	values, err := buildRequestFieldValues(fields, "No VPN", "", []string{
+		"urgency=high",
+		"Systems=VPN, 11",
+		"customfield_10030=3",
+		"Labels=remote,vpn",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, _ := json.Marshal(values)
+	want := `{"customfield_10010":{"id":"1"},"customfield_10020":[{"id":"10"},{"id":"11"}],"customfield_10030":3,"labels":["remote","vpn"],"summary":"No VPN"}`
+	if string(got) != want {
+		t.Errorf("unexpected values:\n got %s\nwant %s", got, want)
+	}
+
+	_, err = buildRequestFieldValues(fields, "No VPN", "", nil)
+	if err == nil || !strings.Contains(err.Error(), "Urgency") {
+		t.Errorf("expected missing Urgency error, got %v", err)
+	}
+
+	_, err = buildRequestFieldValues(fields, "No VPN", "", []string{"Urgency=Critical"})
+	if err == nil || !strings.Contains(err.Error(), "valid: High, Low") {
+		t.Errorf("expected invalid option error, got %v", err)
+	}
+
+	for _, bad := range []string{"Urgency", "Nope=1", "Seats=many"} {
+		if _, err := buildRequestFieldValues(fields, "s", "", []string{"Urgency=Low", bad}); err == nil {
+			t.Errorf("expected error for %q", bad)
+		}
+	}
+}
+
+func TestCreateRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/rest/servicedeskapi/request" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var req requestCreateRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if req.ServiceDeskID != "4" || req.RequestTypeID != "12" || req.RequestFieldValues["summary"] != "Help" {
+			t.Errorf("unexpected request: %+v", req)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"issueId": "10100", "issueKey": "SUP-12", "_links": {"web": "https://example.atlassian.net/servicedesk/customer/portal/4/SUP-12"}}`))
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	result, err := createRequest(context.Background(), client, requestCreateRequest{
+		ServiceDeskID:      "4",
+		RequestTypeID:      "12",
+		RequestFieldValues: map[string]any{"summary": "Help"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Key != "SUP-12" || result.ID != "10100" || !strings.HasSuffix(result.PortalURL, "/SUP-12") {
+		t.Errorf("unexpected result: %+v", result)
+	}
+}
+
+func TestAddRequestComment_Internal(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/servicedeskapi/request/SUP-12/comment" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		var req map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if req["public"] != false || req["body"] != "Escalated" {
+			t.Errorf("expected internal comment, got %v", req)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": "900", "public": false, "created": {"iso8601": "2026-04-22T10:00:00+0000"}}`))
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	result, err := addRequestComment(context.Background(), client, "SUP-12", "Escalated", false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.ID != "900" || result.Public || result.Created == "" {
+		t.Errorf("unexpected result: %+v", result)
+	}
+}
+
+func TestFetchRequestSLAs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/servicedeskapi/request/SUP-12/sla" {
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"isLastPage": true, "values": [
+			{"id": "1", "name": "Time to first response",
+			 "completedCycles": [{"startTime": {"iso8601": "2026-04-22T09:00:00+0000"}, "stopTime": {"iso8601": "2026-04-22T09:30:00+0000"},
+			   "breached": false, "goalDuration": {"friendly": "4h"}, "elapsedTime": {"friendly": "30m"}, "remainingTime": {"millis": 12600000, "friendly": "3h 30m"}}]},
+			{"id": "2", "name": "Time to resolution",
+			 "ongoingCycle": {"startTime": {"iso8601": "2026-04-22T09:00:00+0000"}, "breachTime": {"iso8601": "2026-04-23T09:00:00+0000"},
+			   "breached": true, "paused": false, "withinCalendarHours": true,
+			   "goalDuration": {"friendly": "8h"}, "elapsedTime": {"friendly": "9h"}, "remainingTime": {"millis": -3600000, "friendly": "-1h"}}}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	slas, err := fetchRequestSLAs(context.Background(), client, "SUP-12")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(slas) != 2 {
+		t.Fatalf("expected 2 SLAs, got %d", len(slas))
+	}
+	first := slas[0]
+	if first.Ongoing != nil || len(first.Completed) != 1 || first.Completed[0].Remaining != "3h 30m" {
+		t.Errorf("unexpected first SLA: %+v", first)
+	}
+	if got := slaState(first.Completed[0], false); got != "met" {
+		t.Errorf("expected met, got %s", got)
+	}
+
+	second := slas[1]
+	if second.Ongoing == nil || !second.Ongoing.Breached || second.Ongoing.RemainingMillis != -3600000 {
+		t.Errorf("unexpected second SLA: %+v", second)
+	}
+	if second.Ongoing.BreachTime != "2026-04-23T09:00:00+0000" {
+		t.Errorf("unexpected breach time: %s", second.Ongoing.BreachTime)
+	}
+	if got := slaState(*second.Ongoing, true); got != "breached" {
+		t.Errorf("expected breached, got %s", got)
+	}
+}
+
+func TestGetQueueIssues_ByName(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/servicedeskapi/servicedesk/4/queue":
+			if r.URL.Query().Get("includeCount") != "true" {
+				t.Errorf("expected includeCount=true, got %s", r.URL.RawQuery)
+			}
+			_, _ = w.Write([]byte(`{"isLastPage": true, "values": [{"id": "7", "name": "Unassigned issues", "issueCount": 2}]}`))
+		case "/rest/servicedeskapi/servicedesk/4/queue/7/issue":
+			_, _ = w.Write([]byte(`{"isLastPage": true, "values": [
+				{"key": "SUP-3", "fields": {"summary": "VPN down", "status": {"name": "Waiting for support"}}},
+				{"key": "SUP-5", "fields": {"summary": "New laptop", "status": {"name": "Open"}}}
+			]}`))
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	issues, err := getQueueIssues(context.Background(), client, "4", "unassigned issues", 50)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(issues) != 2 || issues[0].Key != "SUP-3" || issues[0].Status != "Waiting for support" {
+		t.Errorf("unexpected issues: %+v", issues)
+	}
+
+	if _, err := getQueueIssues(context.Background(), client, "4", "Nope", 50); err == nil {
+		t.Error("expected error for unknown queue")
+	}
+}
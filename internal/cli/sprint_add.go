@@ -36,8 +36,9 @@ var sprintAddCmd = &cobra.Command{
 		}
 		return nil
 	},
-	SilenceUsage: true,
-	RunE:         runSprintAdd,
+	SilenceUsage:      true,
+	ValidArgsFunction: completeSprintAdd,
+	RunE:              runSprintAdd,
 }
 
 func init() {
@@ -45,6 +46,14 @@ func init() {
 	sprintCmd.AddCommand(sprintAddCmd)
 }
 
+// completeSprintAdd completes the sprint ID, then issue keys.
+func completeSprintAdd(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	if len(args) == 0 {
+		return completeSprints(cmd, args, toComplete)
+	}
+	return completeIssueKeys(cmd, args, toComplete)
+}
+
 func runSprintAdd(cmd *cobra.Command, args []string) error {
 	ctx := cmd.Context()
 
@@ -58,6 +67,9 @@ func runSprintAdd(cmd *cobra.Command, args []string) error {
 		if err != nil {
 			return err
 		}
+		if err := checkBatchPolicy(len(issueKeys)); err != nil {
+			return err
+		}
 		if len(issueKeys) == 0 {
 			return fmt.Errorf("no issue keys provided via stdin")
 		}
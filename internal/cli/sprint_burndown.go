@@ -0,0 +1,283 @@
+package cli
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/grantcarthew/ajira/internal/api"
+	"github.com/grantcarthew/ajira/internal/config"
+	"github.com/grantcarthew/ajira/internal/width"
+	"github.com/spf13/cobra"
+)
+
+// Burndown is the remaining-work series of a sprint.
+type Burndown struct {
+	Sprint SprintInfo      `json:"sprint"`
+	Unit   string          `json:"unit"`
+	Series []BurndownPoint `json:"series"`
+
+	// Skipped lists issues left out because their changelog could not be read.
+	Skipped []string `json:"skipped,omitempty"`
+}
+
+// BurndownPoint is the remaining work at the end of one sprint day.
+// Remaining is null for days that have not happened yet.
+type BurndownPoint struct {
+	Date      string   `json:"date"`
+	Remaining *float64 `json:"remaining"`
+	Ideal     float64  `json:"ideal"`
+}
+
+var (
+	burndownUnit   string
+	burndownFormat string
+	burndownWidth  int
+	burndownHeight int
+)
+
+var sprintBurndownCmd = &cobra.Command{
+	Use:   "burndown <sprint-id>",
+	Short: "Sprint burndown chart",
+	Long: `Reconstruct remaining work per day from issue changelogs and draw it against an ideal line.
+Remaining work is the story points (or issue count) of issues in the sprint that are not in a done status.`,
+	Example: `  ajira sprint burndown 42                    # ASCII chart
+  ajira sprint burndown 42 --unit issues      # Count issues instead of points
+  ajira sprint burndown 42 --format csv       # date,remaining,ideal
+  ajira sprint burndown 42 --json`,
+	Args:              cobra.ExactArgs(1),
+	SilenceUsage:      true,
+	ValidArgsFunction: completeFirstArg(completeSprints),
+	RunE:              runSprintBurndown,
+}
+
+func init() {
+	sprintBurndownCmd.Flags().StringVar(&burndownUnit, "unit", "points", "Unit of work: points, issues")
+	sprintBurndownCmd.Flags().StringVar(&burndownFormat, "format", "text", "Output format: text, csv, json")
+	sprintBurndownCmd.Flags().IntVar(&burndownWidth, "width", 80, "Chart width in columns")
+	sprintBurndownCmd.Flags().IntVar(&burndownHeight, "height", 15, "Chart height in rows")
+
+	sprintCmd.AddCommand(sprintBurndownCmd)
+}
+
+func runSprintBurndown(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	sprintID := args[0]
+
+	format := burndownFormat
+	if JSONOutput() {
+		format = "json"
+	}
+	switch format {
+	case "text", "csv", "json":
+	default:
+		return fmt.Errorf("invalid format: %s (valid: text, csv, json)", format)
+	}
+	if burndownUnit != "points" && burndownUnit != "issues" {
+		return fmt.Errorf("invalid unit: %s (valid: points, issues)", burndownUnit)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(cfg)
+
+	h, err := loadSprintHistory(ctx, client, sprintID)
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); ok {
+			return fmt.Errorf("API error: %w", apiErr)
+		}
+		return fmt.Errorf("failed to build burndown: %w", err)
+	}
+
+	unit := burndownUnit
+	if unit == "points" && h.PointsField == "" {
+		unit = "issues"
+	}
+
+	burndown, err := computeBurndown(h, unit)
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case "json":
+		output, err := json.MarshalIndent(burndown, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to format JSON: %w", err)
+		}
+		fmt.Println(string(output))
+	case "csv":
+		return writeBurndownCSV(os.Stdout, burndown)
+	default:
+		fmt.Printf("%s  %s\n\n", burndown.Sprint.Name, colorSprintState(burndown.Sprint.State, burndown.Sprint.State))
+		renderBurndownChart(os.Stdout, burndown, burndownWidth, burndownHeight)
+	}
+
+	return nil
+}
+
+// computeBurndown samples remaining work at the end of each day from the
+// sprint start to its planned end (or completion, if later). Days after the
+// replay window are left without a remaining value.
+func computeBurndown(h *sprintHistory, unit string) (*Burndown, error) {
+	plannedEnd := h.End
+	if h.Sprint.EndDate != "" {
+		t, err := parseJiraTime(h.Sprint.EndDate)
+		if err != nil {
+			return nil, err
+		}
+		plannedEnd = t
+	}
+	lastDay := plannedEnd
+	if h.End.After(lastDay) {
+		lastDay = h.End
+	}
+
+	timelines := make([]*issueTimeline, len(h.Issues))
+	for i, issue := range h.Issues {
+		timelines[i] = newIssueTimeline(h, issue)
+	}
+
+	remainingAt := func(t time.Time) float64 {
+		var total float64
+		for _, tl := range timelines {
+			if !tl.inSprintAt(t) || h.Categories[tl.statusAt(t)] == "done" {
+				continue
+			}
+			if unit == "points" {
+				total += tl.pointsAt(t)
+			} else {
+				total++
+			}
+		}
+		return total
+	}
+
+	b := &Burndown{Sprint: h.Sprint, Unit: unit, Series: []BurndownPoint{}, Skipped: h.Skipped}
+
+	startDay := truncateDay(h.Start)
+	for day := startDay; !day.After(truncateDay(lastDay)); day = day.AddDate(0, 0, 1) {
+		point := BurndownPoint{Date: day.Format("2006-01-02")}
+		if !day.After(h.End) {
+			sample := day.AddDate(0, 0, 1).Add(-time.Nanosecond)
+			if sample.After(h.End) {
+				sample = h.End
+			}
+			v := remainingAt(sample)
+			point.Remaining = &v
+		}
+		b.Series = append(b.Series, point)
+	}
+
+	// Ideal line runs from the committed scope at start to zero on the
+	// planned end day.
+	initial := remainingAt(h.Start)
+	idealDays := int(truncateDay(plannedEnd).Sub(startDay).Hours()/24 + 0.5)
+	for i := range b.Series {
+		if idealDays > 0 && i < idealDays {
+			b.Series[i].Ideal = initial * float64(idealDays-i) / float64(idealDays)
+		}
+	}
+
+	return b, nil
+}
+
+// truncateDay returns midnight at the start of t's day in t's location.
+func truncateDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+func writeBurndownCSV(w io.Writer, b *Burndown) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"date", "remaining", "ideal"}); err != nil {
+		return err
+	}
+	for _, p := range b.Series {
+		remaining := ""
+		if p.Remaining != nil {
+			remaining = formatPoints(*p.Remaining)
+		}
+		if err := cw.Write([]string{p.Date, remaining, formatPoints(math.Round(p.Ideal*100) / 100)}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// renderBurndownChart draws the series as an ASCII chart: '*' marks remaining
+// work and '.' the ideal line. chartWidth includes the y-axis labels.
+func renderBurndownChart(w io.Writer, b *Burndown, chartWidth, height int) {
+	if len(b.Series) == 0 {
+		fmt.Fprintln(w, "No sprint days to chart.")
+		return
+	}
+	height = max(height, 3)
+
+	yMax := 0.0
+	for _, p := range b.Series {
+		yMax = max(yMax, p.Ideal)
+		if p.Remaining != nil {
+			yMax = max(yMax, *p.Remaining)
+		}
+	}
+	if yMax == 0 {
+		yMax = 1
+	}
+
+	labels := map[int]string{
+		0:          formatPoints(yMax),
+		height / 2: formatPoints(math.Round(yMax/2*10) / 10),
+		height - 1: "0",
+	}
+	labelWidth := 0
+	for _, l := range labels {
+		labelWidth = max(labelWidth, width.StringWidth(l))
+	}
+	plotWidth := max(chartWidth-labelWidth-2, 10)
+
+	grid := make([][]byte, height)
+	for r := range grid {
+		grid[r] = []byte(strings.Repeat(" ", plotWidth))
+	}
+	rowOf := func(v float64) int {
+		return height - 1 - int(math.Round(v/yMax*float64(height-1)))
+	}
+
+	days := len(b.Series)
+	for c := range plotWidth {
+		x := 0.0
+		if plotWidth > 1 {
+			x = float64(c) * float64(days-1) / float64(plotWidth-1)
+		}
+		i := int(x)
+		next := min(i+1, days-1)
+
+		ideal := b.Series[i].Ideal + (b.Series[next].Ideal-b.Series[i].Ideal)*(x-float64(i))
+		grid[rowOf(ideal)][c] = '.'
+
+		if p := b.Series[i]; p.Remaining != nil {
+			grid[rowOf(*p.Remaining)][c] = '*'
+		}
+	}
+
+	for r, line := range grid {
+		label := labels[r]
+		fmt.Fprintf(w, "%s%s |%s\n", strings.Repeat(" ", labelWidth-width.StringWidth(label)), label, string(line))
+	}
+	fmt.Fprintf(w, "%s +%s\n", strings.Repeat(" ", labelWidth), strings.Repeat("-", plotWidth))
+
+	first, last := b.Series[0].Date, b.Series[days-1].Date
+	gap := max(plotWidth-width.StringWidth(first)-width.StringWidth(last), 1)
+	fmt.Fprintf(w, "%s  %s%s%s\n", strings.Repeat(" ", labelWidth), first, strings.Repeat(" ", gap), last)
+	fmt.Fprintf(w, "%s  * remaining (%s)  . ideal\n", strings.Repeat(" ", labelWidth), b.Unit)
+}
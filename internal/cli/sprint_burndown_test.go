@@ -0,0 +1,201 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/grantcarthew/ajira/internal/api"
+)
+
+// fixtureHandler replays recorded Jira responses from testdata/sprint_burndown.
+func fixtureHandler(t *testing.T) http.HandlerFunc {
+	dir := filepath.Join("testdata", "sprint_burndown")
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		var name string
+		switch path := r.URL.Path; {
+		case path == "/rest/agile/1.0/sprint/42":
+			name = "sprint.json"
+		case path == "/rest/api/3/field":
+			name = "field.json"
+		case path == "/rest/api/3/status":
+			name = "status.json"
+		case path == "/rest/api/3/search/jql":
+			name = "search_project.json"
+			if strings.HasPrefix(r.URL.Query().Get("jql"), "sprint = ") {
+				name = "search_sprint.json"
+			}
+		case strings.HasSuffix(path, "/changelog"):
+			key := strings.TrimSuffix(strings.TrimPrefix(path, "/rest/api/3/issue/"), "/changelog")
+			name = "changelog_" + key + ".json"
+		}
+
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if name == "" || err != nil {
+			t.Errorf("no fixture for %s", r.URL)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(data)
+	}
+}
+
+func loadFixtureHistory(t *testing.T) *sprintHistory {
+	t.Helper()
+	server := httptest.NewServer(fixtureHandler(t))
+	t.Cleanup(server.Close)
+
+	client := api.NewClient(testConfig(server.URL))
+	h, err := loadSprintHistory(context.Background(), client, "42")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return h
+}
+
+func TestLoadSprintHistory_SkipsUnreadableChangelog(t *testing.T) {
+	fixtures := fixtureHandler(t)
+	var candidateJQL string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if jql := r.URL.Query().Get("jql"); strings.HasPrefix(jql, "project in") {
+			candidateJQL = jql
+		}
+		if r.URL.Path == "/rest/api/3/issue/GCP-4/changelog" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		fixtures(w, r)
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	h, err := loadSprintHistory(context.Background(), client, "42")
+	if err != nil {
+		t.Fatalf("expected the report to continue, got: %v", err)
+	}
+
+	var keys []string
+	for _, issue := range h.Issues {
+		keys = append(keys, issue.Key)
+	}
+	if strings.Join(keys, ",") != "GCP-1,GCP-2,GCP-3" {
+		t.Errorf("expected GCP-4 to be skipped, got %v", keys)
+	}
+	if report := computeSprintReport(h); strings.Join(report.Skipped, ",") != "GCP-4" {
+		t.Errorf("expected GCP-4 listed as skipped in the report, got %v", report.Skipped)
+	}
+	if !strings.Contains(candidateJQL, "openSprints(), futureSprints()") {
+		t.Errorf("expected candidates narrowed to the backlog and open sprints, got %q", candidateJQL)
+	}
+}
+
+func TestComputeBurndown_Points(t *testing.T) {
+	b, err := computeBurndown(loadFixtureHistory(t), "points")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantDates := []string{"2026-01-05", "2026-01-06", "2026-01-07", "2026-01-08", "2026-01-09"}
+	wantRemaining := []float64{14, 13, 10, 5, 5}
+	wantIdeal := []float64{14, 10.5, 7, 3.5, 0}
+
+	if len(b.Series) != len(wantDates) {
+		t.Fatalf("expected %d days, got %d: %+v", len(wantDates), len(b.Series), b.Series)
+	}
+	for i, p := range b.Series {
+		if p.Date != wantDates[i] {
+			t.Errorf("day %d: expected date %s, got %s", i, wantDates[i], p.Date)
+		}
+		if p.Remaining == nil || *p.Remaining != wantRemaining[i] {
+			t.Errorf("day %d: expected remaining %v, got %v", i, wantRemaining[i], p.Remaining)
+		}
+		if p.Ideal != wantIdeal[i] {
+			t.Errorf("day %d: expected ideal %v, got %v", i, wantIdeal[i], p.Ideal)
+		}
+	}
+}
+
+func TestComputeBurndown_Issues(t *testing.T) {
+	b, err := computeBurndown(loadFixtureHistory(t), "issues")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []float64{3, 3, 2, 1, 1}
+	for i, p := range b.Series {
+		if p.Remaining == nil || *p.Remaining != want[i] {
+			t.Errorf("day %d: expected %v issues remaining, got %v", i, want[i], p.Remaining)
+		}
+	}
+}
+
+func TestSprintReport_Fixture(t *testing.T) {
+	report := computeSprintReport(loadFixtureHistory(t))
+
+	if report.Committed.Issues != 3 || report.Committed.Points != 14 {
+		t.Errorf("unexpected committed: %+v", report.Committed)
+	}
+	if strings.Join(report.Added.Keys, ",") != "GCP-3" {
+		t.Errorf("expected GCP-3 added, got %v", report.Added.Keys)
+	}
+	if strings.Join(report.Removed.Keys, ",") != "GCP-4" {
+		t.Errorf("expected GCP-4 removed, got %v", report.Removed.Keys)
+	}
+	if report.Completed.Points != 5 || report.NotCompleted.Points != 5 {
+		t.Errorf("unexpected completion: completed=%+v notCompleted=%+v", report.Completed, report.NotCompleted)
+	}
+}
+
+func TestWriteBurndownCSV(t *testing.T) {
+	remaining := 4.0
+	b := &Burndown{Series: []BurndownPoint{
+		{Date: "2026-01-05", Remaining: &remaining, Ideal: 4},
+		{Date: "2026-01-06", Ideal: 2.6666666},
+	}}
+
+	var buf bytes.Buffer
+	if err := writeBurndownCSV(&buf, b); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := "date,remaining,ideal\n2026-01-05,4,4\n2026-01-06,,2.67\n"
+	if buf.String() != want {
+		t.Errorf("unexpected CSV:\n%s", buf.String())
+	}
+}
+
+func TestRenderBurndownChart(t *testing.T) {
+	b, err := computeBurndown(loadFixtureHistory(t), "points")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	renderBurndownChart(&buf, b, 40, 8)
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+
+	if len(lines) != 11 {
+		t.Fatalf("expected 8 rows plus axis, dates, legend; got %d lines:\n%s", len(lines), buf.String())
+	}
+	if !strings.HasPrefix(lines[0], "14 |*") {
+		t.Errorf("expected top row to start at 14 with remaining mark, got %q", lines[0])
+	}
+	if !strings.HasPrefix(lines[7], " 0 |") || !strings.HasSuffix(lines[7], ".") {
+		t.Errorf("expected bottom row to end on the ideal line, got %q", lines[7])
+	}
+	for _, line := range lines[:8] {
+		if w := len(line); w != 40 {
+			t.Errorf("expected chart rows 40 columns wide, got %d: %q", w, line)
+		}
+	}
+	if !strings.Contains(lines[9], "2026-01-05") || !strings.HasSuffix(lines[9], "2026-01-09") {
+		t.Errorf("unexpected date axis %q", lines[9])
+	}
+}
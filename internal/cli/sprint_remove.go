@@ -0,0 +1,117 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/grantcarthew/ajira/internal/api"
+	"github.com/grantcarthew/ajira/internal/config"
+	"github.com/spf13/cobra"
+)
+
+// backlogMoveRequest matches the Jira Agile API request for moving issues to the backlog.
+type backlogMoveRequest struct {
+	Issues []string `json:"issues"`
+}
+
+var sprintRemoveStdin bool
+
+var sprintRemoveCmd = &cobra.Command{
+	Use:   "remove <issue-keys...>",
+	Short: "Remove from sprint",
+	Long:  "Move issues out of their sprint and back to the backlog. Use --stdin for batch.",
+	Example: `  ajira sprint remove GCP-123 GCP-124
+  echo -e "GCP-1\nGCP-2" | ajira sprint remove --stdin`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if sprintRemoveStdin {
+			if len(args) != 0 {
+				return fmt.Errorf("with --stdin, no arguments should be provided")
+			}
+		} else {
+			if len(args) < 1 {
+				return fmt.Errorf("requires at least 1 argument: <issue-keys...>")
+			}
+		}
+		return nil
+	},
+	SilenceUsage: true,
+	RunE:         runSprintRemove,
+}
+
+func init() {
+	sprintRemoveCmd.Flags().BoolVar(&sprintRemoveStdin, "stdin", false, "Read issue keys from stdin (one per line)")
+	sprintCmd.AddCommand(sprintRemoveCmd)
+}
+
+func runSprintRemove(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	var issueKeys []string
+	var err error
+
+	if sprintRemoveStdin {
+		issueKeys, err = ReadKeysFromStdin()
+		if err != nil {
+			return err
+		}
+		if err := checkBatchPolicy(len(issueKeys)); err != nil {
+			return err
+		}
+		if len(issueKeys) == 0 {
+			return fmt.Errorf("no issue keys provided via stdin")
+		}
+	} else {
+		issueKeys = args
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(cfg)
+
+	// Dry-run mode
+	if DryRun() {
+		PrintDryRunBatch(issueKeys, "move to backlog")
+		return nil
+	}
+
+	err = moveIssuesToBacklog(ctx, client, issueKeys)
+	if err != nil {
+		return err
+	}
+
+	if JSONOutput() {
+		PrintSuccessJSON(map[string]any{
+			"issues": issueKeys,
+			"count":  len(issueKeys),
+		})
+	} else {
+		if len(issueKeys) == 1 {
+			PrintSuccess("Moved 1 issue to the backlog")
+		} else {
+			PrintSuccess(fmt.Sprintf("Moved %d issues to the backlog", len(issueKeys)))
+		}
+	}
+
+	return nil
+}
+
+// moveIssuesToBacklog removes issues from any sprint, in batches of the
+// Agile API's per-call limit.
+func moveIssuesToBacklog(ctx context.Context, client *api.Client, issueKeys []string) error {
+	for batch := range slices.Chunk(issueKeys, agileBatchSize) {
+		body, err := json.Marshal(backlogMoveRequest{Issues: batch})
+		if err != nil {
+			return fmt.Errorf("failed to marshal request: %w", err)
+		}
+
+		if _, err := client.AgilePost(ctx, "/backlog/issue", body); err != nil {
+			return err
+		}
+	}
+	return nil
+}
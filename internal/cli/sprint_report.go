@@ -0,0 +1,519 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/grantcarthew/ajira/internal/api"
+	"github.com/grantcarthew/ajira/internal/config"
+	"github.com/grantcarthew/ajira/internal/width"
+	"github.com/spf13/cobra"
+)
+
+// SprintReport summarises sprint commitment, scope change, and completion.
+type SprintReport struct {
+	Sprint       SprintInfo      `json:"sprint"`
+	Committed    SprintTotals    `json:"committed"`
+	Added        SprintTotals    `json:"added"`
+	Removed      SprintTotals    `json:"removed"`
+	Completed    SprintTotals    `json:"completed"`
+	NotCompleted SprintTotals    `json:"notCompleted"`
+	Velocity     *SprintVelocity `json:"velocity,omitempty"`
+	Skipped      []string        `json:"skipped,omitempty"`
+}
+
+// SprintTotals counts issues and story points in one report category.
+type SprintTotals struct {
+	Issues int      `json:"issues"`
+	Points float64  `json:"points"`
+	Keys   []string `json:"keys"`
+}
+
+// SprintVelocity holds completed work across recent closed sprints.
+type SprintVelocity struct {
+	Sprints       []SprintVelocityEntry `json:"sprints"`
+	AverageIssues float64               `json:"averageIssues"`
+	AveragePoints float64               `json:"averagePoints"`
+}
+
+// SprintVelocityEntry is the completed work of a single closed sprint.
+type SprintVelocityEntry struct {
+	ID     int     `json:"id"`
+	Name   string  `json:"name"`
+	Issues int     `json:"issues"`
+	Points float64 `json:"points"`
+}
+
+// sprintFieldValue matches an entry of the Sprint custom field.
+type sprintFieldValue struct {
+	ID int `json:"id"`
+}
+
+var sprintReportVelocity int
+
+var sprintReportCmd = &cobra.Command{
+	Use:   "report <sprint-id>",
+	Short: "Sprint report",
+	Long: `Report committed vs completed work for a sprint, with issues added and removed after the sprint started.
+Scope changes are reconstructed from issue changelogs. Removed issues are found among issues now in the backlog
+or an open sprint; issues whose changelog cannot be read are left out and listed as skipped. Velocity across recent closed sprints requires --board or JIRA_BOARD.`,
+	Example: `  ajira sprint report 42                  # Commitment, scope change, completion
+  ajira sprint report 42 --velocity 8     # Velocity over the last 8 closed sprints
+  ajira sprint report 42 --json`,
+	Args:              cobra.ExactArgs(1),
+	SilenceUsage:      true,
+	ValidArgsFunction: completeFirstArg(completeSprints),
+	RunE:              runSprintReport,
+}
+
+func init() {
+	sprintReportCmd.Flags().IntVar(&sprintReportVelocity, "velocity", 5, "Closed sprints to include in velocity (0 = skip)")
+
+	sprintCmd.AddCommand(sprintReportCmd)
+}
+
+func runSprintReport(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	sprintID := args[0]
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(cfg)
+
+	report, err := buildSprintReport(ctx, client, sprintID)
+	if err == nil && sprintReportVelocity > 0 && Board() != "" {
+		report.Velocity, err = sprintVelocity(ctx, client, Board(), sprintReportVelocity)
+	}
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); ok {
+			return fmt.Errorf("API error: %w", apiErr)
+		}
+		return fmt.Errorf("failed to build sprint report: %w", err)
+	}
+
+	if JSONOutput() {
+		output, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to format JSON: %w", err)
+		}
+		fmt.Println(string(output))
+	} else {
+		printSprintReport(report)
+	}
+
+	return nil
+}
+
+// sprintHistory is everything needed to replay a sprint: candidate issues,
+// their changelogs, and the field IDs and status categories to interpret them.
+type sprintHistory struct {
+	Sprint      SprintInfo
+	Start, End  time.Time
+	Issues      []issueValue
+	Histories   map[string][]changelogHistory
+	SprintField string
+	PointsField string
+	Categories  map[string]string
+	Skipped     []string
+}
+
+// maxRemovalCandidates bounds the issues checked for removal from a sprint,
+// each of which costs a changelog request.
+const maxRemovalCandidates = 500
+
+func buildSprintReport(ctx context.Context, client *api.Client, sprintID string) (*SprintReport, error) {
+	h, err := loadSprintHistory(ctx, client, sprintID)
+	if err != nil {
+		return nil, err
+	}
+	return computeSprintReport(h), nil
+}
+
+// loadSprintHistory gathers every issue that may have been in the sprint
+// along with their changelogs: current members, plus issues in the same
+// projects updated since the sprint started that are now in the backlog or
+// another open sprint, where issues removed mid-sprint go. Issues whose
+// changelog cannot be read are left out and listed in Skipped.
+func loadSprintHistory(ctx context.Context, client *api.Client, sprintID string) (*sprintHistory, error) {
+	sprint, err := getSprint(ctx, client, sprintID)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end, err := sprintWindow(*sprint, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := fetchFields(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	sprintField := fieldIDByName(fields, "Sprint")
+	if sprintField == "" {
+		return nil, fmt.Errorf("no Sprint field found on this Jira instance")
+	}
+	pointsField := fieldIDByName(fields, storyPointsFieldNames...)
+
+	categories, err := fetchStatusCategories(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	requested := issueListFields + "," + sprintField
+	if pointsField != "" {
+		requested += "," + pointsField
+	}
+
+	members, err := searchIssueValues(ctx, client, fmt.Sprintf("sprint = %d", sprint.ID), requested, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	issues := members
+	if projects := projectKeysOf(members); len(projects) > 0 {
+		jql := fmt.Sprintf("project in (%s) AND updated >= \"%s\" AND (sprint is EMPTY OR (sprint != %d AND sprint in (openSprints(), futureSprints()))) ORDER BY updated DESC",
+			strings.Join(projects, ", "), start.AddDate(0, 0, -1).Format("2006-01-02"), sprint.ID)
+		others, err := searchIssueValues(ctx, client, jql, requested, maxRemovalCandidates)
+		if err != nil {
+			return nil, err
+		}
+		if len(others) == maxRemovalCandidates {
+			fmt.Fprintf(os.Stderr, "Warning: checked only the %d most recently updated issues for removal from the sprint\n", maxRemovalCandidates)
+		}
+		issues = append(issues, others...)
+	}
+
+	keys := make([]string, len(issues))
+	for i, issue := range issues {
+		keys[i] = issue.Key
+	}
+	histories, err := fetchChangelogs(ctx, client, keys)
+	var skipped []string
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, err
+		}
+		fmt.Fprintf(os.Stderr, "Warning: skipping issues whose changelog could not be read:\n%v\n", err)
+		issues = slices.DeleteFunc(issues, func(issue issueValue) bool {
+			_, ok := histories[issue.Key]
+			if !ok {
+				skipped = append(skipped, issue.Key)
+			}
+			return !ok
+		})
+	}
+
+	return &sprintHistory{
+		Sprint:      *sprint,
+		Start:       start,
+		End:         end,
+		Issues:      issues,
+		Histories:   histories,
+		SprintField: sprintField,
+		PointsField: pointsField,
+		Categories:  categories,
+		Skipped:     skipped,
+	}, nil
+}
+
+// sprintWindow returns the period a sprint report covers: from start to
+// completion, or to now for an active sprint.
+func sprintWindow(sprint SprintInfo, now time.Time) (time.Time, time.Time, error) {
+	if sprint.StartDate == "" {
+		return time.Time{}, time.Time{}, fmt.Errorf("sprint %d has not started", sprint.ID)
+	}
+	start, err := parseJiraTime(sprint.StartDate)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	end := now
+	closedAt := sprint.CompleteDate
+	if closedAt == "" && sprint.State == "closed" {
+		closedAt = sprint.EndDate
+	}
+	if closedAt != "" {
+		if end, err = parseJiraTime(closedAt); err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+	}
+
+	return start, end, nil
+}
+
+// issueTimeline replays one issue's sprint membership, status, and story
+// points from its changelog.
+type issueTimeline struct {
+	sprintID       string
+	sprintChanges  []fieldChange
+	statusChanges  []fieldChange
+	pointChanges   []fieldChange
+	currentSprints string
+	currentStatus  string
+	currentPoints  string
+}
+
+func newIssueTimeline(h *sprintHistory, issue issueValue) *issueTimeline {
+	histories := h.Histories[issue.Key]
+	tl := &issueTimeline{
+		sprintID:       strconv.Itoa(h.Sprint.ID),
+		sprintChanges:  fieldChanges(histories, h.SprintField, "Sprint"),
+		statusChanges:  fieldChanges(histories, "status", "status"),
+		pointChanges:   fieldChanges(histories, h.PointsField, ""),
+		currentSprints: currentSprintIDs(issue.Fields.Raw[h.SprintField]),
+	}
+	if issue.Fields.Status != nil {
+		tl.currentStatus = issue.Fields.Status.ID
+	}
+	if v := fieldNumber(issue.Fields.Raw, h.PointsField); v != nil {
+		tl.currentPoints = formatPoints(*v)
+	}
+	return tl
+}
+
+func (tl *issueTimeline) inSprintAt(t time.Time) bool {
+	return slices.Contains(splitIDs(stateAt(tl.sprintChanges, tl.currentSprints, t, changeIDs)), tl.sprintID)
+}
+
+// addedDuring reports whether the issue entered the sprint within (start, end].
+func (tl *issueTimeline) addedDuring(start, end time.Time) bool {
+	for _, c := range tl.sprintChanges {
+		if c.At.After(start) && !c.At.After(end) && slices.Contains(splitIDs(c.To), tl.sprintID) {
+			return true
+		}
+	}
+	return false
+}
+
+func (tl *issueTimeline) statusAt(t time.Time) string {
+	return stateAt(tl.statusChanges, tl.currentStatus, t, changeIDs)
+}
+
+func (tl *issueTimeline) pointsAt(t time.Time) float64 {
+	v, _ := strconv.ParseFloat(stateAt(tl.pointChanges, tl.currentPoints, t, changeStrings), 64)
+	return v
+}
+
+// computeSprintReport classifies issues by replaying the Sprint, status, and
+// story points fields over the sprint window.
+func computeSprintReport(h *sprintHistory) *SprintReport {
+	report := &SprintReport{Sprint: h.Sprint, Skipped: h.Skipped}
+	start, end := h.Start, h.End
+
+	for _, issue := range h.Issues {
+		tl := newIssueTimeline(h, issue)
+
+		committed := tl.inSprintAt(start)
+		if !committed && !tl.addedDuring(start, end) {
+			continue
+		}
+
+		if committed {
+			report.Committed.add(issue.Key, tl.pointsAt(start))
+		} else {
+			report.Added.add(issue.Key, tl.pointsAt(end))
+		}
+
+		switch {
+		case !tl.inSprintAt(end):
+			report.Removed.add(issue.Key, tl.pointsAt(end))
+		case h.Categories[tl.statusAt(end)] == "done":
+			report.Completed.add(issue.Key, tl.pointsAt(end))
+		default:
+			report.NotCompleted.add(issue.Key, tl.pointsAt(end))
+		}
+	}
+
+	for _, t := range []*SprintTotals{&report.Committed, &report.Added, &report.Removed, &report.Completed, &report.NotCompleted} {
+		if t.Keys == nil {
+			t.Keys = []string{}
+		}
+	}
+
+	return report
+}
+
+func (t *SprintTotals) add(key string, points float64) {
+	t.Issues++
+	t.Points += points
+	t.Keys = append(t.Keys, key)
+}
+
+// currentSprintIDs renders the Sprint field's present value in the same
+// comma-separated ID form the changelog uses.
+func currentSprintIDs(raw json.RawMessage) string {
+	var sprints []sprintFieldValue
+	if err := json.Unmarshal(raw, &sprints); err != nil {
+		return ""
+	}
+	ids := make([]string, len(sprints))
+	for i, s := range sprints {
+		ids[i] = strconv.Itoa(s.ID)
+	}
+	return strings.Join(ids, ", ")
+}
+
+// splitIDs splits a comma-separated ID list as stored in changelog items.
+func splitIDs(s string) []string {
+	var ids []string
+	for _, id := range strings.Split(s, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// projectKeysOf returns the distinct project keys of issues, sorted.
+func projectKeysOf(issues []issueValue) []string {
+	var keys []string
+	for _, issue := range issues {
+		if i := strings.LastIndex(issue.Key, "-"); i > 0 && !slices.Contains(keys, issue.Key[:i]) {
+			keys = append(keys, issue.Key[:i])
+		}
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// sprintVelocity computes completed work for the last n closed sprints of a
+// board. An issue counts as completed in a sprint if it is in that sprint and
+// reached the done category no later than the sprint's completion day.
+func sprintVelocity(ctx context.Context, client *api.Client, boardID string, n int) (*SprintVelocity, error) {
+	sprints, err := listSprints(ctx, client, boardID, "closed", 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(sprints) > n {
+		sprints = sprints[len(sprints)-n:]
+	}
+
+	pointsField, err := findStoryPointsField(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	requested := "status"
+	if pointsField != "" {
+		requested += "," + pointsField
+	}
+
+	velocity := &SprintVelocity{Sprints: []SprintVelocityEntry{}}
+	for _, s := range sprints {
+		jql := fmt.Sprintf("sprint = %d AND statusCategory = Done", s.ID)
+		if complete, err := parseJiraTime(s.CompleteDate); err == nil {
+			jql += fmt.Sprintf(" AND statusCategoryChangedDate < \"%s\"", complete.AddDate(0, 0, 1).Format("2006-01-02"))
+		}
+
+		issues, err := searchIssueValues(ctx, client, jql, requested, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		entry := SprintVelocityEntry{ID: s.ID, Name: s.Name, Issues: len(issues)}
+		for _, issue := range issues {
+			if v := fieldNumber(issue.Fields.Raw, pointsField); v != nil {
+				entry.Points += *v
+			}
+		}
+		velocity.Sprints = append(velocity.Sprints, entry)
+		velocity.AverageIssues += float64(entry.Issues)
+		velocity.AveragePoints += entry.Points
+	}
+
+	if count := len(velocity.Sprints); count > 0 {
+		velocity.AverageIssues /= float64(count)
+		velocity.AveragePoints /= float64(count)
+	}
+
+	return velocity, nil
+}
+
+func printSprintReport(report *SprintReport) {
+	bold := color.New(color.Bold).SprintFunc()
+	header := color.New(color.FgCyan, color.Bold).SprintFunc()
+	faint := color.New(color.Faint).SprintFunc()
+
+	s := report.Sprint
+	fmt.Printf("%s  %s\n", bold(s.Name), colorSprintState(s.State, s.State))
+	end := s.CompleteDate
+	if end == "" {
+		end = s.EndDate
+	}
+	fmt.Printf("Dates:   %s to %s\n", formatSprintDate(s.StartDate), formatSprintDate(end))
+	if s.Goal != "" {
+		fmt.Printf("Goal:    %s\n", s.Goal)
+	}
+	fmt.Println()
+
+	rows := []struct {
+		label  string
+		totals SprintTotals
+	}{
+		{"Committed", report.Committed},
+		{"Added", report.Added},
+		{"Removed", report.Removed},
+		{"Completed", report.Completed},
+		{"Not completed", report.NotCompleted},
+	}
+
+	fmt.Printf("%s  %s  %s\n",
+		header(width.PadRight("", 13)),
+		header(width.PadRight("ISSUES", 6)),
+		header("POINTS"))
+	for _, r := range rows {
+		fmt.Printf("%s  %s  %s\n",
+			bold(width.PadRight(r.label, 13)),
+			width.PadRight(strconv.Itoa(r.totals.Issues), 6),
+			formatPoints(r.totals.Points))
+	}
+
+	// List the keys behind scope changes and carry-over
+	for _, r := range rows {
+		if r.label == "Committed" || r.label == "Completed" || len(r.totals.Keys) == 0 {
+			continue
+		}
+		fmt.Printf("\n%s %s\n", bold(r.label+":"), strings.Join(r.totals.Keys, ", "))
+	}
+	if len(report.Skipped) > 0 {
+		fmt.Printf("\n%s %s %s\n", bold("Skipped:"), strings.Join(report.Skipped, ", "), faint("(changelog could not be read; not counted)"))
+	}
+
+	if v := report.Velocity; v != nil && len(v.Sprints) > 0 {
+		nameWidth := 7
+		for _, e := range v.Sprints {
+			if w := width.StringWidth(e.Name); w > nameWidth {
+				nameWidth = w
+			}
+		}
+		if nameWidth > 30 {
+			nameWidth = 30
+		}
+
+		fmt.Printf("\n%s %s\n", bold("Velocity"), faint(fmt.Sprintf("(last %d closed sprints)", len(v.Sprints))))
+		fmt.Printf("%s  %s  %s\n",
+			header(width.PadRight("SPRINT", nameWidth)),
+			header(width.PadRight("ISSUES", 6)),
+			header("POINTS"))
+		for _, e := range v.Sprints {
+			fmt.Printf("%s  %s  %s\n",
+				width.PadRight(width.Truncate(e.Name, nameWidth, "..."), nameWidth),
+				width.PadRight(strconv.Itoa(e.Issues), 6),
+				formatPoints(e.Points))
+		}
+		fmt.Printf("%s  %s  %s\n",
+			bold(width.PadRight("Average", nameWidth)),
+			width.PadRight(strconv.FormatFloat(v.AverageIssues, 'f', 1, 64), 6),
+			strconv.FormatFloat(v.AveragePoints, 'f', 1, 64))
+	}
+}
@@ -3,10 +3,12 @@ package cli
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/grantcarthew/ajira/internal/api"
 )
@@ -252,3 +254,160 @@ func TestColorSprintState(t *testing.T) {
 		}
 	}
 }
+
+func TestGetSprintView_GroupsWithPoints(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/rest/agile/1.0/sprint/42":
+			_, _ = w.Write([]byte(`{"id":42,"name":"Sprint 7","state":"active","goal":"Ship login"}`))
+		case "/rest/api/3/field":
+			_, _ = w.Write([]byte(`[{"id":"summary","name":"Summary"},{"id":"customfield_10016","name":"Story point estimate","custom":true}]`))
+		case "/rest/agile/1.0/sprint/42/issue":
+			if !strings.Contains(r.URL.Query().Get("fields"), "customfield_10016") {
+				t.Errorf("expected story points field requested, got %s", r.URL.Query().Get("fields"))
+			}
+			_, _ = w.Write([]byte(`{"total":3,"issues":[
+				{"key":"GCP-1","fields":{"summary":"Done","status":{"name":"Done","statusCategory":{"key":"done"}},"customfield_10016":3}},
+				{"key":"GCP-2","fields":{"summary":"Todo","status":{"name":"To Do","statusCategory":{"key":"new"}},"customfield_10016":5}},
+				{"key":"GCP-3","fields":{"summary":"Todo unestimated","status":{"name":"To Do","statusCategory":{"key":"new"}},"customfield_10016":null}}
+			]}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	view, err := getSprintView(context.Background(), client, "42")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if view.Name != "Sprint 7" || view.Goal != "Ship login" {
+		t.Errorf("unexpected sprint info: %+v", view.SprintInfo)
+	}
+	if view.TotalIssues != 3 || view.TotalPoints != 8 || view.DonePoints != 3 {
+		t.Errorf("unexpected totals: issues=%d points=%v done=%v", view.TotalIssues, view.TotalPoints, view.DonePoints)
+	}
+	if len(view.Groups) != 2 {
+		t.Fatalf("expected 2 status groups, got %d", len(view.Groups))
+	}
+	if view.Groups[0].Status != "To Do" || view.Groups[0].Count != 2 || view.Groups[0].Points != 5 {
+		t.Errorf("expected To Do group first with 5 points, got %+v", view.Groups[0])
+	}
+	if view.Groups[0].Issues[1].Points != nil {
+		t.Errorf("expected nil points for unestimated issue")
+	}
+}
+
+func TestMoveIssuesToBacklog_Batches(t *testing.T) {
+	var sizes []int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/rest/agile/1.0/backlog/issue" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var req backlogMoveRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		sizes = append(sizes, len(req.Issues))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	keys := make([]string, 75)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("GCP-%d", i+1)
+	}
+
+	client := api.NewClient(testConfig(server.URL))
+	if err := moveIssuesToBacklog(context.Background(), client, keys); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(sizes) != 2 || sizes[0] != 50 || sizes[1] != 25 {
+		t.Errorf("expected batches of 50 and 25, got %v", sizes)
+	}
+}
+
+func TestComputeSprintReport(t *testing.T) {
+	start, _ := time.Parse(time.RFC3339, "2026-01-05T00:00:00Z")
+	end, _ := time.Parse(time.RFC3339, "2026-01-19T00:00:00Z")
+	categories := map[string]string{"1": "new", "3": "indeterminate", "10": "done"}
+
+	var issues []issueValue
+	if err := json.Unmarshal([]byte(`[
+		{"key": "GCP-1", "fields": {"status": {"id": "10"}, "customfield_10020": [{"id": 42}], "customfield_10016": 5}},
+		{"key": "GCP-2", "fields": {"status": {"id": "3"}, "customfield_10020": [{"id": 42}, {"id": 43}], "customfield_10016": 8}},
+		{"key": "GCP-3", "fields": {"status": {"id": "10"}, "customfield_10020": [{"id": 42}], "customfield_10016": 2}},
+		{"key": "GCP-4", "fields": {"status": {"id": "1"}, "customfield_10020": [], "customfield_10016": 3}},
+		{"key": "GCP-5", "fields": {"status": {"id": "10"}, "customfield_10020": [], "customfield_10016": 1}}
+	]`), &issues); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// GCP-1: committed and completed; points raised from 3 to 5 mid-sprint
+	// GCP-2: committed, not completed, carried over to sprint 43
+	// GCP-3: added mid-sprint and completed
+	// GCP-4: committed then removed back to the backlog
+	// GCP-5: never in the sprint
+	histories := map[string][]changelogHistory{
+		"GCP-1": {
+			{Created: "2026-01-01T00:00:00.000+0000", Items: []changelogItem{{Field: "Sprint", FieldID: "customfield_10020", To: "42"}}},
+			{Created: "2026-01-07T00:00:00.000+0000", Items: []changelogItem{{Field: "Story point estimate", FieldID: "customfield_10016", FromString: "3", ToString: "5"}}},
+			{Created: "2026-01-10T00:00:00.000+0000", Items: []changelogItem{{Field: "status", FieldID: "status", From: "1", To: "10"}}},
+		},
+		"GCP-2": {
+			{Created: "2026-01-20T00:00:00.000+0000", Items: []changelogItem{{Field: "Sprint", FieldID: "customfield_10020", From: "42", To: "42, 43"}}},
+		},
+		"GCP-3": {
+			{Created: "2026-01-08T00:00:00.000+0000", Items: []changelogItem{{Field: "Sprint", FieldID: "customfield_10020", To: "42"}}},
+		},
+		"GCP-4": {
+			{Created: "2026-01-01T00:00:00.000+0000", Items: []changelogItem{{Field: "Sprint", FieldID: "customfield_10020", To: "42"}}},
+			{Created: "2026-01-09T00:00:00.000+0000", Items: []changelogItem{{Field: "Sprint", FieldID: "customfield_10020", From: "42"}}},
+		},
+	}
+
+	report := computeSprintReport(&sprintHistory{
+		Sprint:      SprintInfo{ID: 42},
+		Start:       start,
+		End:         end,
+		Issues:      issues,
+		Histories:   histories,
+		SprintField: "customfield_10020",
+		PointsField: "customfield_10016",
+		Categories:  categories,
+	})
+
+	check := func(name string, got SprintTotals, issues int, points float64) {
+		t.Helper()
+		if got.Issues != issues || got.Points != points {
+			t.Errorf("%s: expected %d issues / %v points, got %d / %v (%v)", name, issues, points, got.Issues, got.Points, got.Keys)
+		}
+	}
+	check("committed", report.Committed, 3, 14)
+	check("added", report.Added, 1, 2)
+	check("removed", report.Removed, 1, 3)
+	check("completed", report.Completed, 2, 7)
+	check("notCompleted", report.NotCompleted, 1, 8)
+}
+
+func TestSprintWindow(t *testing.T) {
+	now := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	_, end, err := sprintWindow(SprintInfo{ID: 1, State: "active", StartDate: "2026-01-05T00:00:00.000Z"}, now)
+	if err != nil || !end.Equal(now) {
+		t.Errorf("expected active sprint to end now, got %v (%v)", end, err)
+	}
+
+	_, end, err = sprintWindow(SprintInfo{ID: 1, State: "closed", StartDate: "2026-01-05T00:00:00.000Z", CompleteDate: "2026-01-08T12:00:00.000Z"}, now)
+	if err != nil || end.Day() != 8 {
+		t.Errorf("expected closed sprint to end on completion, got %v (%v)", end, err)
+	}
+
+	if _, _, err := sprintWindow(SprintInfo{ID: 1, State: "future"}, now); err == nil {
+		t.Error("expected error for sprint that has not started")
+	}
+}
@@ -0,0 +1,242 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+
+	"github.com/fatih/color"
+	"github.com/grantcarthew/ajira/internal/api"
+	"github.com/grantcarthew/ajira/internal/config"
+	"github.com/grantcarthew/ajira/internal/width"
+	"github.com/spf13/cobra"
+)
+
+// SprintView represents a sprint and its issues grouped by status.
+type SprintView struct {
+	SprintInfo
+	TotalIssues int                 `json:"totalIssues"`
+	TotalPoints float64             `json:"totalPoints"`
+	DonePoints  float64             `json:"donePoints"`
+	Groups      []SprintStatusGroup `json:"groups"`
+}
+
+// SprintStatusGroup holds the sprint issues sharing a status.
+type SprintStatusGroup struct {
+	Status         string        `json:"status"`
+	StatusCategory string        `json:"statusCategory"`
+	Count          int           `json:"count"`
+	Points         float64       `json:"points"`
+	Issues         []SprintIssue `json:"issues"`
+}
+
+// SprintIssue is an issue with its story point estimate.
+type SprintIssue struct {
+	IssueInfo
+	Points *float64 `json:"points"`
+}
+
+var sprintViewCmd = &cobra.Command{
+	Use:   "view <sprint-id>",
+	Short: "View sprint details",
+	Long:  "Display a sprint's goal, dates, and issues grouped by status with story point totals.",
+	Example: `  ajira sprint view 42
+  ajira sprint view 42 --json`,
+	Args:              cobra.ExactArgs(1),
+	SilenceUsage:      true,
+	ValidArgsFunction: completeFirstArg(completeSprints),
+	RunE:              runSprintView,
+}
+
+func init() {
+	sprintCmd.AddCommand(sprintViewCmd)
+}
+
+func runSprintView(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+	sprintID := args[0]
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(cfg)
+
+	view, err := getSprintView(ctx, client, sprintID)
+	if err != nil {
+		if apiErr, ok := err.(*api.APIError); ok {
+			return fmt.Errorf("API error: %w", apiErr)
+		}
+		return fmt.Errorf("failed to view sprint: %w", err)
+	}
+
+	if JSONOutput() {
+		output, err := json.MarshalIndent(view, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to format JSON: %w", err)
+		}
+		fmt.Println(string(output))
+	} else {
+		printSprintView(view)
+	}
+
+	return nil
+}
+
+// getSprint fetches a single sprint by ID.
+func getSprint(ctx context.Context, client *api.Client, sprintID string) (*SprintInfo, error) {
+	body, err := client.AgileGet(ctx, fmt.Sprintf("/sprint/%s", url.PathEscape(sprintID)))
+	if err != nil {
+		return nil, err
+	}
+
+	var s sprintValue
+	if err := json.Unmarshal(body, &s); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	info := SprintInfo(s)
+	return &info, nil
+}
+
+func getSprintView(ctx context.Context, client *api.Client, sprintID string) (*SprintView, error) {
+	sprint, err := getSprint(ctx, client, sprintID)
+	if err != nil {
+		return nil, err
+	}
+
+	pointsField, err := findStoryPointsField(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := issueListFields
+	if pointsField != "" {
+		fields += "," + pointsField
+	}
+
+	issues, err := fetchAgileIssues(ctx, client, fmt.Sprintf("/sprint/%s/issue", url.PathEscape(sprintID)), fields, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	view := groupSprintIssues(issues, pointsField)
+	view.SprintInfo = *sprint
+	return view, nil
+}
+
+// groupSprintIssues groups issues by status, ordered by status category
+// (to do, in progress, done) and then by first appearance.
+func groupSprintIssues(issues []issueValue, pointsField string) *SprintView {
+	view := &SprintView{Groups: []SprintStatusGroup{}}
+	index := make(map[string]int)
+
+	for _, v := range issues {
+		issue := SprintIssue{
+			IssueInfo: issueInfoFromValue(v),
+			Points:    fieldNumber(v.Fields.Raw, pointsField),
+		}
+
+		i, ok := index[issue.Status]
+		if !ok {
+			i = len(view.Groups)
+			index[issue.Status] = i
+			view.Groups = append(view.Groups, SprintStatusGroup{
+				Status:         issue.Status,
+				StatusCategory: issue.StatusCategory,
+			})
+		}
+
+		g := &view.Groups[i]
+		g.Issues = append(g.Issues, issue)
+		g.Count++
+		view.TotalIssues++
+		if issue.Points != nil {
+			g.Points += *issue.Points
+			view.TotalPoints += *issue.Points
+			if issue.StatusCategory == "done" {
+				view.DonePoints += *issue.Points
+			}
+		}
+	}
+
+	slices.SortStableFunc(view.Groups, func(a, b SprintStatusGroup) int {
+		return statusCategoryOrder(a.StatusCategory) - statusCategoryOrder(b.StatusCategory)
+	})
+
+	return view
+}
+
+// statusCategoryOrder ranks status categories in workflow order.
+func statusCategoryOrder(category string) int {
+	switch category {
+	case "new":
+		return 0
+	case "indeterminate":
+		return 1
+	case "done":
+		return 2
+	default:
+		return 3
+	}
+}
+
+// formatPoints renders a point value without trailing zeros.
+func formatPoints(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func printSprintView(view *SprintView) {
+	bold := color.New(color.Bold).SprintFunc()
+	header := color.New(color.FgCyan, color.Bold).SprintFunc()
+	faint := color.New(color.Faint).SprintFunc()
+
+	fmt.Printf("%s  %s\n", bold(view.Name), colorSprintState(view.State, view.State))
+	if view.Goal != "" {
+		fmt.Printf("Goal:    %s\n", view.Goal)
+	}
+	fmt.Printf("Dates:   %s to %s\n", formatSprintDate(view.StartDate), formatSprintDate(view.EndDate))
+	fmt.Printf("Issues:  %d\n", view.TotalIssues)
+	fmt.Printf("Points:  %s (%s done)\n", formatPoints(view.TotalPoints), formatPoints(view.DonePoints))
+
+	keyWidth, typeWidth := 3, 4
+	for _, g := range view.Groups {
+		for _, issue := range g.Issues {
+			if w := width.StringWidth(issue.Key); w > keyWidth {
+				keyWidth = w
+			}
+			if w := width.StringWidth(issue.Type); w > typeWidth {
+				typeWidth = w
+			}
+		}
+	}
+
+	for _, g := range view.Groups {
+		fmt.Println()
+		fmt.Printf("%s %s\n",
+			header(g.Status),
+			faint(fmt.Sprintf("(%d issues, %s pts)", g.Count, formatPoints(g.Points))))
+
+		for _, issue := range g.Issues {
+			assignee := issue.Assignee
+			if assignee == "" {
+				assignee = "-"
+			}
+			points := "-"
+			if issue.Points != nil {
+				points = formatPoints(*issue.Points)
+			}
+
+			fmt.Printf("  %s  %s  %s  %s  %s\n",
+				bold(width.PadRight(issue.Key, keyWidth)),
+				width.PadRight(issue.Type, typeWidth),
+				width.PadRight(points, 4),
+				width.PadRight(width.Truncate(issue.Summary, 50, "..."), 50),
+				faint(assignee))
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grantcarthew/ajira/internal/api"
+)
+
+// TimeTracking holds an issue's estimates and logged time.
+type TimeTracking struct {
+	OriginalEstimate         string `json:"originalEstimate,omitempty"`
+	RemainingEstimate        string `json:"remainingEstimate,omitempty"`
+	TimeSpent                string `json:"timeSpent,omitempty"`
+	OriginalEstimateSeconds  int    `json:"originalEstimateSeconds,omitempty"`
+	RemainingEstimateSeconds int    `json:"remainingEstimateSeconds,omitempty"`
+	TimeSpentSeconds         int    `json:"timeSpentSeconds,omitempty"`
+}
+
+// timeTrackingConfig matches the time tracking part of the /configuration
+// API response.
+type timeTrackingConfig struct {
+	Enabled            bool `json:"timeTrackingEnabled"`
+	TimeTrackingConfig struct {
+		DefaultUnit string `json:"defaultUnit"`
+	} `json:"timeTrackingConfiguration"`
+}
+
+// durationToken matches one component of a Jira duration such as "2d" or
+// "1.5h". A bare number takes the instance's default unit.
+var durationToken = regexp.MustCompile(`^(\d+(?:\.\d+)?)\s*([wdhm]?)\s*`)
+
+// durationUnits maps the configured default unit to its duration suffix.
+var durationUnits = map[string]string{
+	"minute": "m",
+	"hour":   "h",
+	"day":    "d",
+	"week":   "w",
+}
+
+// fetchTimeTrackingConfig reads whether time tracking is enabled and its
+// default unit.
+func fetchTimeTrackingConfig(ctx context.Context, client *api.Client) (*timeTrackingConfig, error) {
+	body, err := client.Get(ctx, "/configuration")
+	if err != nil {
+		return nil, err
+	}
+
+	var cfg timeTrackingConfig
+	if err := json.Unmarshal(body, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	return &cfg, nil
+}
+
+// timeTrackingUpdate builds the timetracking field value for the given
+// estimates. Returns nil when both are empty. Durations are validated
+// against the instance configuration and normalised, for example
+// "1w2d" becomes "1w 2d".
+func timeTrackingUpdate(ctx context.Context, client *api.Client, estimate, remaining string) (map[string]string, error) {
+	if estimate == "" && remaining == "" {
+		return nil, nil
+	}
+
+	cfg, err := fetchTimeTrackingConfig(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	if !cfg.Enabled {
+		return nil, fmt.Errorf("time tracking is disabled on this Jira instance")
+	}
+
+	unit := durationUnits[cfg.TimeTrackingConfig.DefaultUnit]
+	if unit == "" {
+		unit = "m"
+	}
+
+	fields := make(map[string]string)
+	if estimate != "" {
+		d, err := parseJiraDuration(estimate, unit)
+		if err != nil {
+			return nil, fmt.Errorf("invalid estimate: %w", err)
+		}
+		fields["originalEstimate"] = d
+	}
+	if remaining != "" {
+		d, err := parseJiraDuration(remaining, unit)
+		if err != nil {
+			return nil, fmt.Errorf("invalid remaining estimate: %w", err)
+		}
+		fields["remainingEstimate"] = d
+	}
+	return fields, nil
+}
+
+// parseJiraDuration validates a duration in Jira syntax (w, d, h, m) and
+// returns it normalised with one space between components. A lone number
+// uses defaultUnit.
+func parseJiraDuration(s, defaultUnit string) (string, error) {
+	rest := strings.ToLower(strings.TrimSpace(s))
+	if rest == "" {
+		return "", fmt.Errorf("empty duration")
+	}
+
+	var parts []string
+	seen := make(map[string]bool)
+	for rest != "" {
+		m := durationToken.FindStringSubmatch(rest)
+		if m == nil {
+			return "", fmt.Errorf("%q is not a Jira duration (e.g. 2h, 1d 4h, 1w)", s)
+		}
+		unit := m[2]
+		if unit == "" {
+			if len(parts) > 0 || len(m[0]) != len(rest) {
+				return "", fmt.Errorf("%q is not a Jira duration (e.g. 2h, 1d 4h, 1w)", s)
+			}
+			unit = defaultUnit
+		}
+		if seen[unit] {
+			return "", fmt.Errorf("%q repeats the %s unit", s, unit)
+		}
+		seen[unit] = true
+
+		parts = append(parts, m[1]+unit)
+		rest = rest[len(m[0]):]
+	}
+
+	return strings.Join(parts, " "), nil
+}
+
+// relativeDue matches relative due dates such as "+3d" or "+2w".
+var relativeDue = regexp.MustCompile(`^\+(\d+)([dw])$`)
+
+// parseDueDate converts an ISO date (2026-05-01) or a relative form (today,
+// tomorrow, +3d, +2w, friday, next-friday) to an ISO date. Weekday names
+// mean the next such day after today.
+func parseDueDate(s string, now time.Time) (string, error) {
+	v := strings.ToLower(strings.TrimSpace(s))
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	if t, err := time.Parse("2006-01-02", v); err == nil {
+		return t.Format("2006-01-02"), nil
+	}
+
+	switch v {
+	case "today":
+		return today.Format("2006-01-02"), nil
+	case "tomorrow":
+		return today.AddDate(0, 0, 1).Format("2006-01-02"), nil
+	}
+
+	if m := relativeDue.FindStringSubmatch(v); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		if m[2] == "w" {
+			n *= 7
+		}
+		return today.AddDate(0, 0, n).Format("2006-01-02"), nil
+	}
+
+	name := strings.TrimPrefix(v, "next-")
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.ToLower(d.String()) == name {
+			ahead := (int(d) - int(today.Weekday()) + 7) % 7
+			if ahead == 0 {
+				ahead = 7
+			}
+			return today.AddDate(0, 0, ahead).Format("2006-01-02"), nil
+		}
+	}
+
+	return "", fmt.Errorf("invalid due date: %s (use YYYY-MM-DD, today, tomorrow, +3d, +2w, or next-friday)", s)
+}
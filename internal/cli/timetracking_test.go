@@ -0,0 +1,224 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/grantcarthew/ajira/internal/api"
+)
+
+func TestParseJiraDuration(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{"2h", "2h", false},
+		{"1w2d", "1w 2d", false},
+		{"1d 4h", "1d 4h", false},
+		{"1.5h", "1.5h", false},
+		{"3", "3h", false},
+		{"2H 30M", "2h 30m", false},
+		{"", "", true},
+		{"soon", "", true},
+		{"2x", "", true},
+		{"1d 3", "", true},
+		{"1h 2h", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := parseJiraDuration(tt.input, "h")
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected error for %q, got %q", tt.input, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestParseDueDate(t *testing.T) {
+	// Wednesday
+	now := time.Date(2026, 4, 22, 15, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{"2026-05-01", "2026-05-01", false},
+		{"today", "2026-04-22", false},
+		{"Tomorrow", "2026-04-23", false},
+		{"+3d", "2026-04-25", false},
+		{"+2w", "2026-05-06", false},
+		{"friday", "2026-04-24", false},
+		{"next-friday", "2026-04-24", false},
+		{"wednesday", "2026-04-29", false},
+		{"2026-13-01", "", true},
+		{"someday", "", true},
+		{"+3m", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := parseDueDate(tt.input, now)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected error for %q, got %q", tt.input, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestTimeTrackingUpdate(t *testing.T) {
+	server := jsonServer(map[string]any{
+		"/rest/api/3/configuration": `{"timeTrackingEnabled": true, "timeTrackingConfiguration": {"defaultUnit": "day"}}`,
+	})
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	fields, err := timeTrackingUpdate(context.Background(), client, "2", "1d4h")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fields["originalEstimate"] != "2d" {
+		t.Errorf("expected originalEstimate 2d, got %q", fields["originalEstimate"])
+	}
+	if fields["remainingEstimate"] != "1d 4h" {
+		t.Errorf("expected remainingEstimate '1d 4h', got %q", fields["remainingEstimate"])
+	}
+
+	_, err = timeTrackingUpdate(context.Background(), client, "tomorrow", "")
+	if err == nil {
+		t.Error("expected error for invalid estimate")
+	}
+}
+
+func TestTimeTrackingUpdate_Empty(t *testing.T) {
+	fields, err := timeTrackingUpdate(context.Background(), nil, "", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fields != nil {
+		t.Errorf("expected nil fields, got %v", fields)
+	}
+}
+
+func TestTimeTrackingUpdate_Disabled(t *testing.T) {
+	server := jsonServer(map[string]any{
+		"/rest/api/3/configuration": `{"timeTrackingEnabled": false, "timeTrackingConfiguration": {"defaultUnit": "day"}}`,
+	})
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	_, err := timeTrackingUpdate(context.Background(), client, "2h", "")
+	if err == nil {
+		t.Fatal("expected error when time tracking is disabled")
+	}
+}
+
+func TestGetIssue_TimeTracking(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"key": "TEST-1",
+			"fields": {
+				"summary": "Estimated",
+				"duedate": "2026-05-01",
+				"timetracking": {
+					"originalEstimate": "1d",
+					"remainingEstimate": "4h",
+					"timeSpent": "4h",
+					"originalEstimateSeconds": 28800,
+					"remainingEstimateSeconds": 14400,
+					"timeSpentSeconds": 14400
+				}
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	issue, err := getIssue(context.Background(), client, "TEST-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if issue.DueDate != "2026-05-01" {
+		t.Errorf("expected due date 2026-05-01, got %q", issue.DueDate)
+	}
+	if issue.TimeTracking == nil {
+		t.Fatal("expected time tracking")
+	}
+	if issue.TimeTracking.OriginalEstimate != "1d" || issue.TimeTracking.RemainingEstimateSeconds != 14400 {
+		t.Errorf("unexpected time tracking: %+v", issue.TimeTracking)
+	}
+}
+
+func TestGetIssue_NoTimeTracking(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"key": "TEST-2", "fields": {"summary": "Plain", "timetracking": {}}}`))
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	issue, err := getIssue(context.Background(), client, "TEST-2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if issue.TimeTracking != nil {
+		t.Errorf("expected no time tracking, got %+v", issue.TimeTracking)
+	}
+}
+
+func TestBuildEditRequest_EditsOneEstimate(t *testing.T) {
+	server := jsonServer(map[string]any{
+		"/rest/api/3/configuration": `{"timeTrackingEnabled": true, "timeTrackingConfiguration": {"defaultUnit": "day"}}`,
+	})
+	defer server.Close()
+
+	editRemaining = "3h"
+	defer func() { editRemaining = "" }()
+
+	client := api.NewClient(testConfig(server.URL))
+	fields, update, _, err := buildEditRequest(context.Background(), client, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := fields["timetracking"]; ok {
+		t.Errorf("expected timetracking not to be replaced, got %v", fields["timetracking"])
+	}
+	body, _ := json.Marshal(update["timetracking"])
+	if string(body) != `[{"edit":{"remainingEstimate":"3h"}}]` {
+		t.Errorf("unexpected timetracking update: %s", body)
+	}
+
+	var issue issueValue
+	if err := json.Unmarshal([]byte(`{"key": "TEST-1", "fields": {"timetracking": {"originalEstimate": "2d", "remainingEstimate": "1d"}}}`), &issue); err != nil {
+		t.Fatal(err)
+	}
+	changes := editChanges(issue, fields, update, "")
+	if len(changes) != 1 || changes[0] != (FieldChange{Field: "remainingEstimate", From: "1d", To: "3h"}) {
+		t.Errorf("expected only the remaining estimate to change, got %+v", changes)
+	}
+}
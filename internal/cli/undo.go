@@ -0,0 +1,340 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/grantcarthew/ajira/internal/api"
+	"github.com/grantcarthew/ajira/internal/config"
+	"github.com/grantcarthew/ajira/internal/journal"
+	"github.com/spf13/cobra"
+)
+
+var (
+	undoLast int
+	undoID   string
+)
+
+var undoCmd = &cobra.Command{
+	Use:   "undo",
+	Short: "Undo recent changes",
+	Long: `Revert changes recorded in the local journal, using the field values saved before each change.
+
+Edits (including labels), assignments, transitions, and link additions can be undone. Transitions
+are undone by moving back to the previous status, where the workflow allows it. A change whose
+fields have been changed again since is reported as a conflict and left alone. Other changes are
+reported as failed.
+
+--last N undoes the last N commands; a bulk command counts once. --id undoes one entry from 'ajira log'.`,
+	Example: `  ajira undo                    # Undo the last command
+  ajira undo --last 3 --dry-run # Preview undoing the last three commands
+  ajira undo --id 3f9a2c1b      # Undo one entry listed by ajira log`,
+	Args:         cobra.NoArgs,
+	SilenceUsage: true,
+	RunE:         runUndo,
+}
+
+func init() {
+	undoCmd.Flags().IntVar(&undoLast, "last", 1, "Undo the last N commands")
+	undoCmd.Flags().StringVar(&undoID, "id", "", "Undo the journal entry with this ID")
+	rootCmd.AddCommand(undoCmd)
+}
+
+func runUndo(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	if undoID != "" && cmd.Flags().Changed("last") {
+		return fmt.Errorf("cannot use --last with --id")
+	}
+
+	entries, err := readJournal()
+	if err != nil {
+		return err
+	}
+
+	selected, err := selectUndoEntries(entries, undoLast, undoID)
+	if err != nil {
+		return err
+	}
+
+	if DryRun() {
+		keys := make([]string, len(selected))
+		for i, e := range selected {
+			keys[i] = fmt.Sprintf("%s (%s %s)", e.ID, e.Command, entryIssue(e))
+		}
+		PrintDryRunBatch(keys, "undo")
+		return nil
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient(cfg)
+
+	var results []BatchResult
+	for _, e := range selected {
+		result := BatchResult{Key: e.ID, Success: true}
+		if err := revertEntry(withReverts(ctx, e.ID), client, e); err != nil {
+			result = failedResult(result.Key, err)
+		}
+		results = append(results, result)
+	}
+
+	return PrintBatchResults(results)
+}
+
+// selectUndoEntries returns the entries to revert, newest first: the entry
+// with id, or every entry of the last commands. Undo entries and entries
+// already undone are skipped.
+func selectUndoEntries(entries []journal.Entry, last int, id string) ([]journal.Entry, error) {
+	reverted := journal.Reverted(entries)
+
+	if id != "" {
+		for _, e := range entries {
+			if e.ID != id {
+				continue
+			}
+			if e.Reverts != "" {
+				return nil, fmt.Errorf("entry %s is an undo and cannot be undone", id)
+			}
+			if reverted[id] {
+				return nil, fmt.Errorf("entry %s has already been undone", id)
+			}
+			return []journal.Entry{e}, nil
+		}
+		return nil, fmt.Errorf("journal entry not found: %s", id)
+	}
+
+	if last < 1 {
+		return nil, fmt.Errorf("--last must be at least 1")
+	}
+
+	var selected []journal.Entry
+	runs := make(map[string]bool)
+	for i := len(entries) - 1; i >= 0; i-- {
+		e := entries[i]
+		if e.Reverts != "" || reverted[e.ID] {
+			continue
+		}
+		if !runs[e.Run] {
+			if len(runs) == last {
+				continue
+			}
+			runs[e.Run] = true
+		}
+		selected = append(selected, e)
+	}
+
+	if len(selected) == 0 {
+		return nil, fmt.Errorf("nothing to undo")
+	}
+	return selected, nil
+}
+
+// revertEntry restores the state saved in an entry's undo record.
+func revertEntry(ctx context.Context, client *api.Client, e journal.Entry) error {
+	u := e.Undo
+	if u == nil {
+		return fmt.Errorf("cannot undo %s %s", e.Method, strings.TrimPrefix(e.Path, "/rest/api/3"))
+	}
+
+	if added := addedEstimates(u.Before["timetracking"], u.After["timetracking"]); len(added) > 0 {
+		return fmt.Errorf("cannot clear %s set by this change; remove it in Jira", strings.Join(added, " and "))
+	}
+
+	switch u.Kind {
+	case journal.KindEdit, journal.KindAssign, journal.KindTransition:
+		if err := checkUnchanged(ctx, client, u); err != nil {
+			return err
+		}
+	}
+
+	switch u.Kind {
+	case journal.KindEdit:
+		fields := make(map[string]any, len(u.Before))
+		for id, raw := range u.Before {
+			fields[id] = restoreFieldValue(id, raw)
+		}
+		return updateIssue(ctx, client, u.Issue, fields, nil)
+
+	case journal.KindAssign:
+		return assignIssue(ctx, client, u.Issue, beforeAccountID(u.Before["assignee"]))
+
+	case journal.KindTransition:
+		var status statusField
+		if err := json.Unmarshal(u.Before["status"], &status); err != nil || status.Name == "" {
+			return fmt.Errorf("previous status not recorded")
+		}
+		transitions, err := getTransitions(ctx, client, u.Issue)
+		if err != nil {
+			return err
+		}
+		t := findTransition(transitions, status.Name)
+		if t == nil {
+			return fmt.Errorf("no transition back to %s", status.Name)
+		}
+		if err := doTransition(api.WithTransition(ctx, t.Name, t.To.Name), client, u.Issue, t.ID, nil, nil); err != nil {
+			return err
+		}
+		if raw, ok := u.Before["assignee"]; ok {
+			return assignIssue(ctx, client, u.Issue, beforeAccountID(raw))
+		}
+		return nil
+
+	case journal.KindLink:
+		if u.Link == nil {
+			return fmt.Errorf("link not recorded")
+		}
+		links, err := getIssueLinks(ctx, client, u.Link.Outward)
+		if err != nil {
+			return err
+		}
+		// From the outward issue, the link lists the inward issue as its
+		// outwardIssue; see createIssueLink.
+		for _, link := range links {
+			if strings.EqualFold(link.Type.Name, u.Link.Type) && link.OutwardIssue != nil && link.OutwardIssue.Key == u.Link.Inward {
+				return deleteIssueLink(ctx, client, link.ID)
+			}
+		}
+		return fmt.Errorf("link %s %s %s no longer exists", u.Link.Outward, u.Link.Type, u.Link.Inward)
+	}
+
+	return fmt.Errorf("unknown undo kind: %s", u.Kind)
+}
+
+// checkUnchanged returns a conflict error if any field saved in an undo
+// record no longer holds the value the change set, so undo does not overwrite
+// a later change.
+func checkUnchanged(ctx context.Context, client *api.Client, u *journal.Undo) error {
+	if len(u.After) == 0 {
+		return fmt.Errorf("result of the change was not recorded, so later changes cannot be detected")
+	}
+
+	ids := make([]string, 0, len(u.After))
+	for id := range u.After {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	current, err := snapshotFields(ctx, client, u.Issue, ids, nil)
+	if err != nil {
+		return err
+	}
+
+	var changed []string
+	for _, id := range ids {
+		if !sameFieldValue(id, u.After[id], current[id]) {
+			changed = append(changed, id)
+		}
+	}
+	if len(changed) > 0 {
+		return fmt.Errorf("conflict: %s has changed since (%s); not overwriting", u.Issue, strings.Join(changed, ", "))
+	}
+	return nil
+}
+
+// sameFieldValue reports whether two values of a field are equal, comparing
+// the references restoreFieldValue keeps so that display details such as
+// avatar URLs do not count as changes.
+func sameFieldValue(id string, a, b json.RawMessage) bool {
+	return canonicalJSON(restoreFieldValue(id, a)) == canonicalJSON(restoreFieldValue(id, b))
+}
+
+// canonicalJSON encodes v with object keys sorted.
+func canonicalJSON(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	var decoded any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return ""
+	}
+	data, _ = json.Marshal(decoded)
+	return string(data)
+}
+
+// addedEstimates returns the estimates present after a change but not
+// before it. Restoring the earlier timetracking value leaves them in place,
+// since the edit API only sets the estimates it is given.
+func addedEstimates(before, after json.RawMessage) []string {
+	if len(after) == 0 {
+		return nil
+	}
+	var b, a TimeTracking
+	_ = json.Unmarshal(before, &b)
+	_ = json.Unmarshal(after, &a)
+
+	var added []string
+	if b.OriginalEstimate == "" && a.OriginalEstimate != "" {
+		added = append(added, "originalEstimate")
+	}
+	if b.RemainingEstimate == "" && a.RemainingEstimate != "" {
+		added = append(added, "remainingEstimate")
+	}
+	return added
+}
+
+// restoreFieldValue converts a field value as returned by Jira into a value
+// the edit API accepts. Objects are reduced to their accountId or id, and
+// rich text and plain values are kept as they are.
+func restoreFieldValue(id string, raw json.RawMessage) any {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+
+	if id == "timetracking" {
+		var tt TimeTracking
+		_ = json.Unmarshal(raw, &tt)
+		v := make(map[string]string)
+		if tt.OriginalEstimate != "" {
+			v["originalEstimate"] = tt.OriginalEstimate
+		}
+		if tt.RemainingEstimate != "" {
+			v["remainingEstimate"] = tt.RemainingEstimate
+		}
+		return v
+	}
+
+	var list []json.RawMessage
+	if err := json.Unmarshal(raw, &list); err == nil {
+		values := make([]any, len(list))
+		for i, item := range list {
+			values[i] = restoreRef(item)
+		}
+		return values
+	}
+	return restoreRef(raw)
+}
+
+// restoreRef reduces an object to the reference the edit API accepts.
+func restoreRef(raw json.RawMessage) any {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &obj); err != nil {
+		return raw
+	}
+	if string(obj["type"]) == `"doc"` {
+		return raw
+	}
+	for _, key := range []string{"accountId", "id"} {
+		if v, ok := obj[key]; ok {
+			return map[string]json.RawMessage{key: v}
+		}
+	}
+	return raw
+}
+
+// beforeAccountID returns the account ID of a saved assignee, or nil if the
+// issue was unassigned.
+func beforeAccountID(raw json.RawMessage) *string {
+	var user userField
+	if err := json.Unmarshal(raw, &user); err != nil || user.AccountID == "" {
+		return nil
+	}
+	return &user.AccountID
+}
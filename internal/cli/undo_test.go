@@ -0,0 +1,282 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/grantcarthew/ajira/internal/api"
+	"github.com/grantcarthew/ajira/internal/journal"
+	"github.com/spf13/cobra"
+)
+
+func TestEnableJournal_RecordsUndo(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal.jsonl")
+	t.Setenv("AJIRA_JOURNAL", path)
+	defer func() {
+		api.SetRecorder(nil)
+		journaling = false
+	}()
+
+	updated := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			if updated {
+				_, _ = w.Write([]byte(`{"key": "TEST-1", "fields": {"summary": "New", "labels": ["a", "b"]}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"key": "TEST-1", "fields": {"summary": "Old", "labels": ["a"]}}`))
+			return
+		}
+		if r.Method == http.MethodPut {
+			updated = true
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	root := &cobra.Command{Use: "ajira"}
+	edit := &cobra.Command{Use: "edit"}
+	root.AddCommand(edit)
+	enableJournal(edit)
+
+	client := api.NewClient(testConfig(server.URL))
+	ctx := context.Background()
+	fields := map[string]any{"summary": "New"}
+	update := map[string]any{"labels": []map[string]string{{"add": "b"}}}
+
+	updateCtx, err := undoContext(ctx, client, journal.KindEdit, "TEST-1", editedFieldIDs(fields, update), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := updateIssue(updateCtx, client, "TEST-1", fields, update); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.Post(ctx, "/field/x/context/mapping", []byte(`{}`)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	entries, err := journal.Read(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("expected 1 entry, got %d", len(entries))
+	}
+	e := entries[0]
+	if e.Command != "edit" || e.Method != http.MethodPut || e.Path != "/rest/api/3/issue/TEST-1" || e.Run != journalRun {
+		t.Errorf("unexpected entry: %+v", e)
+	}
+	if e.Undo == nil || string(e.Undo.Before["summary"]) != `"Old"` || string(e.Undo.Before["labels"]) != `["a"]` {
+		t.Errorf("unexpected undo record: %+v", e.Undo)
+	}
+	if string(e.Undo.After["summary"]) != `"New"` || string(e.Undo.After["labels"]) != `["a","b"]` {
+		t.Errorf("unexpected after values: %+v", e.Undo.After)
+	}
+}
+
+func TestSelectUndoEntries(t *testing.T) {
+	entries := []journal.Entry{
+		{ID: "e1", Run: "r1"},
+		{ID: "e2", Run: "r2"},
+		{ID: "e3", Run: "r2"},
+		{ID: "e4", Run: "r3"},
+		{ID: "u1", Run: "r4", Reverts: "e4"},
+	}
+
+	got, err := selectUndoEntries(entries, 1, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ids := entryIDs(got); ids != "e3,e2" {
+		t.Errorf("expected the last command (e3,e2), got %s", ids)
+	}
+
+	got, _ = selectUndoEntries(entries, 5, "")
+	if ids := entryIDs(got); ids != "e3,e2,e1" {
+		t.Errorf("expected e3,e2,e1, got %s", ids)
+	}
+
+	for id, want := range map[string]string{
+		"e4": "already been undone",
+		"u1": "is an undo",
+		"x":  "not found",
+	} {
+		if _, err := selectUndoEntries(entries, 1, id); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("--id %s: expected error containing %q, got %v", id, want, err)
+		}
+	}
+}
+
+func entryIDs(entries []journal.Entry) string {
+	ids := make([]string, len(entries))
+	for i, e := range entries {
+		ids[i] = e.ID
+	}
+	return strings.Join(ids, ",")
+}
+
+func TestRestoreFieldValue(t *testing.T) {
+	tests := []struct {
+		id   string
+		raw  string
+		want string
+	}{
+		{"summary", `"Old"`, `"Old"`},
+		{"duedate", `null`, `null`},
+		{"priority", `{"self": "x", "id": "3", "name": "Medium"}`, `{"id":"3"}`},
+		{"components", `[{"id": "10", "name": "API"}]`, `[{"id":"10"}]`},
+		{"labels", `[]`, `[]`},
+		{"description", `{"type": "doc", "version": 1, "content": []}`, `{"type":"doc","version":1,"content":[]}`},
+		{"timetracking", `{"originalEstimate": "2h", "originalEstimateSeconds": 7200}`, `{"originalEstimate":"2h"}`},
+		{"timetracking", `{}`, `{}`},
+	}
+	for _, tt := range tests {
+		got, err := json.Marshal(restoreFieldValue(tt.id, json.RawMessage(tt.raw)))
+		if err != nil {
+			t.Fatalf("%s: %v", tt.id, err)
+		}
+		if string(got) != tt.want {
+			t.Errorf("%s: expected %s, got %s", tt.id, tt.want, got)
+		}
+	}
+}
+
+func TestRevertEntry(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+string(body))
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/transitions"):
+			_, _ = w.Write([]byte(`{"transitions": [{"id": "11", "name": "Reopen", "to": {"name": "To Do"}}]}`))
+		case r.Method == http.MethodGet && r.URL.Query().Get("fields") != "issuelinks":
+			_, _ = w.Write([]byte(`{"fields": {"priority": {"id": "1", "name": "Highest"}, "assignee": {"accountId": "abc", "displayName": "Alice"}, "status": {"id": "3", "name": "Done"}}}`))
+		case r.Method == http.MethodGet:
+			_, _ = w.Write([]byte(`{"fields": {"issuelinks": [
+				{"id": "500", "type": {"name": "Relates"}, "outwardIssue": {"key": "TEST-2"}},
+				{"id": "501", "type": {"name": "Blocks"}, "outwardIssue": {"key": "TEST-2"}}
+			]}}`))
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	ctx := context.Background()
+
+	entries := []journal.Entry{
+		{ID: "e1", Undo: &journal.Undo{Kind: journal.KindEdit, Issue: "TEST-1",
+			Before: map[string]json.RawMessage{"priority": json.RawMessage(`{"id": "3", "name": "Medium"}`)},
+			After:  map[string]json.RawMessage{"priority": json.RawMessage(`{"name": "Highest", "id": "1"}`)}}},
+		{ID: "e2", Undo: &journal.Undo{Kind: journal.KindAssign, Issue: "TEST-1",
+			Before: map[string]json.RawMessage{"assignee": json.RawMessage(`null`)},
+			After:  map[string]json.RawMessage{"assignee": json.RawMessage(`{"accountId": "abc"}`)}}},
+		{ID: "e3", Undo: &journal.Undo{Kind: journal.KindTransition, Issue: "TEST-1",
+			Before: map[string]json.RawMessage{"status": json.RawMessage(`{"id": "1", "name": "To Do"}`)},
+			After:  map[string]json.RawMessage{"status": json.RawMessage(`{"id": "3", "name": "Done"}`)}}},
+		{ID: "e4", Undo: &journal.Undo{Kind: journal.KindLink, Issue: "TEST-1", Link: &journal.Link{Type: "Blocks", Outward: "TEST-1", Inward: "TEST-2"}}},
+	}
+	for _, e := range entries {
+		if err := revertEntry(ctx, client, e); err != nil {
+			t.Fatalf("%s: unexpected error: %v", e.ID, err)
+		}
+	}
+
+	want := []string{
+		`GET /rest/api/3/issue/TEST-1 `,
+		`PUT /rest/api/3/issue/TEST-1 {"fields":{"priority":{"id":"3"}}}`,
+		`GET /rest/api/3/issue/TEST-1 `,
+		`PUT /rest/api/3/issue/TEST-1/assignee {"accountId":null}`,
+		`GET /rest/api/3/issue/TEST-1 `,
+		`GET /rest/api/3/issue/TEST-1/transitions `,
+		`POST /rest/api/3/issue/TEST-1/transitions {"transition":{"id":"11"}}`,
+		`GET /rest/api/3/issue/TEST-1 `,
+		`DELETE /rest/api/3/issueLink/501 `,
+	}
+	if strings.Join(requests, "\n") != strings.Join(want, "\n") {
+		t.Errorf("unexpected requests:\n%s", strings.Join(requests, "\n"))
+	}
+
+	err := revertEntry(ctx, client, journal.Entry{ID: "e5", Method: "POST", Path: "/rest/api/3/issue/TEST-1/comment"})
+	if err == nil || err.Error() != "cannot undo POST /issue/TEST-1/comment" {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestRevertEntry_Refuses(t *testing.T) {
+	var writes int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte(`{"fields": {"summary": "Edited again", "assignee": {"accountId": "abc", "avatarUrls": {"48x48": "y"}}}}`))
+			return
+		}
+		writes++
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		undo    *journal.Undo
+		wantErr string
+	}{
+		{
+			name: "field changed since",
+			undo: &journal.Undo{Kind: journal.KindEdit, Issue: "TEST-1",
+				Before: map[string]json.RawMessage{"summary": json.RawMessage(`"Old"`), "assignee": json.RawMessage(`null`)},
+				After:  map[string]json.RawMessage{"summary": json.RawMessage(`"New"`), "assignee": json.RawMessage(`{"accountId": "abc", "avatarUrls": {"48x48": "x"}}`)}},
+			wantErr: "conflict: TEST-1 has changed since (summary)",
+		},
+		{
+			name: "estimate added to an issue without one",
+			undo: &journal.Undo{Kind: journal.KindEdit, Issue: "TEST-1",
+				Before: map[string]json.RawMessage{"timetracking": json.RawMessage(`{}`)},
+				After:  map[string]json.RawMessage{"timetracking": json.RawMessage(`{"originalEstimate": "2h", "originalEstimateSeconds": 7200}`)}},
+			wantErr: "cannot clear originalEstimate",
+		},
+		{
+			name: "result not recorded",
+			undo: &journal.Undo{Kind: journal.KindAssign, Issue: "TEST-1",
+				Before: map[string]json.RawMessage{"assignee": json.RawMessage(`null`)}},
+			wantErr: "not recorded",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := revertEntry(ctx, client, journal.Entry{ID: "e1", Undo: tt.undo})
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+	if writes != 0 {
+		t.Errorf("expected no changes to be sent, got %d", writes)
+	}
+}
+
+func TestEntryIssue(t *testing.T) {
+	tests := map[string]string{
+		"/rest/api/3/issue/TEST-1/comment": "TEST-1",
+		"/rest/api/3/issue":                "",
+		"/rest/api/3/issueLink":            "",
+		"/rest/agile/1.0/issue/rank":       "",
+	}
+	for path, want := range tests {
+		if got := entryIssue(journal.Entry{Path: path}); got != want {
+			t.Errorf("%s: expected %q, got %q", path, want, got)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Policy limits the changes ajira may make, for handing it to automated
+// agents. The zero value allows everything the API token allows.
+type Policy struct {
+	ReadOnly    bool     // Block every change
+	NoDelete    bool     // Block DELETE requests
+	Projects    []string // Allowed project keys (empty = any)
+	Commands    []string // Allowed commands, such as "issue edit" (empty = any)
+	Transitions []string // Allowed transition or target status names (empty = any)
+	MaxBatch    int      // Maximum issues one command may change (0 = unlimited)
+}
+
+// Active reports whether the policy restricts anything.
+func (p *Policy) Active() bool {
+	return p.ReadOnly || p.NoDelete || len(p.Projects) > 0 || len(p.Commands) > 0 ||
+		len(p.Transitions) > 0 || p.MaxBatch > 0
+}
+
+// LoadPolicy reads the policy from AJIRA_* environment variables. Invalid
+// values are errors rather than ignored, so a typo never lifts a limit.
+func LoadPolicy() (*Policy, error) {
+	p := &Policy{
+		Projects:    splitList(os.Getenv("AJIRA_ALLOW_PROJECTS"), true),
+		Commands:    splitList(os.Getenv("AJIRA_ALLOW_COMMANDS"), false),
+		Transitions: splitList(os.Getenv("AJIRA_ALLOW_TRANSITIONS"), false),
+	}
+
+	var errs []error
+	var err error
+	if p.ReadOnly, err = envBool("AJIRA_READONLY"); err != nil {
+		errs = append(errs, err)
+	}
+	if p.NoDelete, err = envBool("AJIRA_NO_DELETE"); err != nil {
+		errs = append(errs, err)
+	}
+	if v := os.Getenv("AJIRA_MAX_BATCH"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			errs = append(errs, fmt.Errorf("invalid AJIRA_MAX_BATCH: must be a positive number, got %q", v))
+		}
+		p.MaxBatch = n
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return p, nil
+}
+
+func envBool(name string) (bool, error) {
+	v := os.Getenv(name)
+	if v == "" {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("invalid %s: must be true or false, got %q", name, v)
+	}
+	return b, nil
+}
+
+// splitList splits a comma-separated list, dropping empty items.
+func splitList(s string, upper bool) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		if upper {
+			item = strings.ToUpper(item)
+		}
+		items = append(items, item)
+	}
+	return items
+}
@@ -0,0 +1,56 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoadPolicy(t *testing.T) {
+	t.Setenv("AJIRA_READONLY", "")
+	t.Setenv("AJIRA_NO_DELETE", "1")
+	t.Setenv("AJIRA_ALLOW_PROJECTS", "proj, ops,")
+	t.Setenv("AJIRA_ALLOW_COMMANDS", "issue edit,issue comment")
+	t.Setenv("AJIRA_ALLOW_TRANSITIONS", "In Progress")
+	t.Setenv("AJIRA_MAX_BATCH", "10")
+
+	p, err := LoadPolicy()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if p.ReadOnly || !p.NoDelete || p.MaxBatch != 10 || !p.Active() {
+		t.Errorf("unexpected policy: %+v", p)
+	}
+	if strings.Join(p.Projects, ",") != "PROJ,OPS" {
+		t.Errorf("expected projects PROJ,OPS, got %v", p.Projects)
+	}
+	if strings.Join(p.Commands, ",") != "issue edit,issue comment" || strings.Join(p.Transitions, ",") != "In Progress" {
+		t.Errorf("unexpected allowlists: %v, %v", p.Commands, p.Transitions)
+	}
+}
+
+func TestLoadPolicy_Empty(t *testing.T) {
+	for _, name := range []string{"AJIRA_READONLY", "AJIRA_NO_DELETE", "AJIRA_ALLOW_PROJECTS", "AJIRA_ALLOW_COMMANDS", "AJIRA_ALLOW_TRANSITIONS", "AJIRA_MAX_BATCH"} {
+		t.Setenv(name, "")
+	}
+
+	p, err := LoadPolicy()
+	if err != nil {
+		t.Fatalf("expected no error, got: %v", err)
+	}
+	if p.Active() {
+		t.Errorf("expected inactive policy, got %+v", p)
+	}
+}
+
+func TestLoadPolicy_InvalidValues(t *testing.T) {
+	t.Setenv("AJIRA_READONLY", "yes please")
+	t.Setenv("AJIRA_MAX_BATCH", "0")
+
+	_, err := LoadPolicy()
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if !strings.Contains(err.Error(), "AJIRA_READONLY") || !strings.Contains(err.Error(), "AJIRA_MAX_BATCH") {
+		t.Errorf("expected both variables in error, got: %v", err)
+	}
+}
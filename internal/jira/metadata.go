@@ -144,6 +144,39 @@ func GetStatuses(ctx context.Context, client *api.Client, projectKey string) ([]
 	return statuses, nil
 }
 
+// GetIssueTypeStatuses fetches the workflow statuses of one issue type in a
+// project.
+func GetIssueTypeStatuses(ctx context.Context, client *api.Client, projectKey, issueTypeID string) ([]Status, error) {
+	path := fmt.Sprintf("/project/%s/statuses", projectKey)
+
+	body, err := client.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp []projectStatusesResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+
+	for _, issueType := range resp {
+		if issueType.ID != issueTypeID {
+			continue
+		}
+		statuses := make([]Status, len(issueType.Statuses))
+		for i, s := range issueType.Statuses {
+			statuses[i] = Status{
+				ID:       s.ID,
+				Name:     s.Name,
+				Category: s.StatusCategory.Name,
+			}
+		}
+		return statuses, nil
+	}
+
+	return nil, fmt.Errorf("issue type %s not found in project %s", issueTypeID, projectKey)
+}
+
 // GetLinkTypes fetches all issue link types from the Jira instance.
 func GetLinkTypes(ctx context.Context, client *api.Client) ([]LinkType, error) {
 	body, err := client.Get(ctx, "/issueLinkType")
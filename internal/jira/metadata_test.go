@@ -333,6 +333,30 @@ func TestGetStatuses_APIError(t *testing.T) {
 	}
 }
 
+func TestGetIssueTypeStatuses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[
+			{"id": "1", "name": "Task", "statuses": [{"id": "10", "name": "To Do"}, {"id": "11", "name": "Done"}]},
+			{"id": "2", "name": "Bug", "statuses": [{"id": "20", "name": "Triage", "statusCategory": {"name": "To Do"}}]}
+		]`))
+	}))
+	defer server.Close()
+
+	client := api.NewClient(testConfig(server.URL))
+	statuses, err := GetIssueTypeStatuses(context.Background(), client, "TEST", "2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(statuses) != 1 || statuses[0].Name != "Triage" || statuses[0].Category != "To Do" {
+		t.Errorf("unexpected statuses: %+v", statuses)
+	}
+
+	if _, err := GetIssueTypeStatuses(context.Background(), client, "TEST", "3"); err == nil {
+		t.Error("expected error for unknown issue type")
+	}
+}
+
 // TestValidatePriority tests priority validation.
 func TestValidatePriority_Valid(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,150 @@
+// Package journal records the changes ajira makes to Jira in a local,
+// append-only JSON Lines file so they can be listed and undone.
+//
+// Each successful mutating request is one entry. Entries for changes that
+// can be reverted carry an Undo with the values fetched before and after
+// the change, so undo can tell whether the issue has changed since.
+// Undoing appends new entries that reference the reverted entry; existing
+// lines are never rewritten.
+package journal
+
+import (
+	"bufio"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Undo kinds.
+const (
+	KindEdit       = "edit"
+	KindAssign     = "assign"
+	KindTransition = "transition"
+	KindLink       = "link"
+)
+
+// Entry is one recorded request.
+type Entry struct {
+	ID      string          `json:"id"`
+	Run     string          `json:"run"`
+	Time    time.Time       `json:"time"`
+	Command string          `json:"command"`
+	Method  string          `json:"method"`
+	Path    string          `json:"path"`
+	Body    json.RawMessage `json:"body,omitempty"`
+	Undo    *Undo           `json:"undo,omitempty"`
+	Reverts string          `json:"reverts,omitempty"`
+}
+
+// Undo describes how to revert an entry.
+type Undo struct {
+	Kind   string                     `json:"kind"`
+	Issue  string                     `json:"issue"`
+	Before map[string]json.RawMessage `json:"before,omitempty"`
+	After  map[string]json.RawMessage `json:"after,omitempty"`
+	Link   *Link                      `json:"link,omitempty"`
+}
+
+// Link identifies an issue link by its ends, as in "Outward Type Inward".
+type Link struct {
+	Type    string `json:"type"`
+	Outward string `json:"outward"`
+	Inward  string `json:"inward"`
+}
+
+// Path returns the journal file path. AJIRA_JOURNAL overrides the default
+// of $XDG_STATE_HOME/ajira/journal.jsonl (~/.local/state when unset). An
+// empty path means journaling is disabled with AJIRA_JOURNAL=off.
+func Path() (string, error) {
+	if p := os.Getenv("AJIRA_JOURNAL"); p != "" {
+		if strings.EqualFold(p, "off") {
+			return "", nil
+		}
+		return p, nil
+	}
+
+	dir := os.Getenv("XDG_STATE_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("locating journal: %w", err)
+		}
+		dir = filepath.Join(home, ".local", "state")
+	}
+	return filepath.Join(dir, "ajira", "journal.jsonl"), nil
+}
+
+// NewID returns a short random identifier for entries and runs.
+func NewID() string {
+	b := make([]byte, 4)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// Append writes e as one line at the end of the journal at path.
+func Append(path string, e Entry) error {
+	line, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("encoding journal entry: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("creating journal directory: %w", err)
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("opening journal: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("writing journal: %w", err)
+	}
+	return nil
+}
+
+// Read returns every entry in the journal at path, oldest first. A missing
+// journal has no entries. Lines that cannot be parsed, such as a line cut
+// short by an interrupted write, are skipped.
+func Read(path string) ([]Entry, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("opening journal: %w", err)
+	}
+	defer f.Close()
+
+	var entries []Entry
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		var e Entry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil || e.ID == "" {
+			continue
+		}
+		entries = append(entries, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading journal: %w", err)
+	}
+	return entries, nil
+}
+
+// Reverted returns the IDs of entries that later entries revert.
+func Reverted(entries []Entry) map[string]bool {
+	reverted := make(map[string]bool)
+	for _, e := range entries {
+		if e.Reverts != "" {
+			reverted[e.Reverts] = true
+		}
+	}
+	return reverted
+}
@@ -0,0 +1,65 @@
+package journal
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPath(t *testing.T) {
+	t.Setenv("AJIRA_JOURNAL", "")
+	t.Setenv("XDG_STATE_HOME", "/tmp/state")
+	if p, _ := Path(); p != "/tmp/state/ajira/journal.jsonl" {
+		t.Errorf("unexpected path: %s", p)
+	}
+
+	t.Setenv("AJIRA_JOURNAL", "/tmp/j.jsonl")
+	if p, _ := Path(); p != "/tmp/j.jsonl" {
+		t.Errorf("unexpected override path: %s", p)
+	}
+
+	t.Setenv("AJIRA_JOURNAL", "off")
+	if p, _ := Path(); p != "" {
+		t.Errorf("expected journaling off, got %s", p)
+	}
+}
+
+func TestAppendRead(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "journal.jsonl")
+
+	entries, err := Read(path)
+	if err != nil || entries != nil {
+		t.Fatalf("expected empty journal, got %v, %v", entries, err)
+	}
+
+	first := Entry{ID: "a1", Method: "PUT", Path: "/rest/api/3/issue/TEST-1", Undo: &Undo{Kind: KindEdit, Issue: "TEST-1"}}
+	if err := Append(path, first); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// A partial line from an interrupted write is skipped.
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = f.WriteString("{\"id\": \"trunc\n")
+	f.Close()
+
+	if err := Append(path, Entry{ID: "b2", Method: "PUT", Reverts: "a1"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	entries, err = Read(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(entries) != 2 || entries[0].ID != "a1" || entries[1].ID != "b2" {
+		t.Fatalf("unexpected entries: %+v", entries)
+	}
+	if entries[0].Undo == nil || entries[0].Undo.Issue != "TEST-1" {
+		t.Errorf("undo not preserved: %+v", entries[0].Undo)
+	}
+	if !Reverted(entries)["a1"] {
+		t.Error("expected a1 to be reverted")
+	}
+}